@@ -2,7 +2,6 @@ package bundle
 
 import (
 	"github.com/code-ready/crc/pkg/crc/config"
-	"github.com/code-ready/crc/pkg/crc/constants"
 	"github.com/code-ready/crc/pkg/crc/logging"
 	"github.com/code-ready/crc/pkg/crc/machine"
 	"github.com/spf13/cobra"
@@ -15,15 +14,19 @@ func getGenerateCmd(config *config.Config) *cobra.Command {
 		Short: "Generate a custom bundle from the running OpenShift cluster",
 		Long:  "Generate a custom bundle from the running OpenShift cluster",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runGenerate(config, forceStop)
+			name, err := cmd.Flags().GetString("instance")
+			if err != nil {
+				return err
+			}
+			return runGenerate(config, name, forceStop)
 		},
 	}
 	generateCmd.PersistentFlags().BoolVarP(&forceStop, "forceStop", "f", false, "Forcefully stop the instance")
 	return generateCmd
 }
 
-func runGenerate(config *config.Config, forceStop bool) error {
-	client := machine.NewClient(constants.DefaultName, isDebugLog(), config)
+func runGenerate(config *config.Config, name string, forceStop bool) error {
+	client := machine.NewClient(name, isDebugLog(), config)
 
 	return client.GenerateBundle(forceStop)
 }
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/cluster"
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	addOutputFormatFlag(certsStatusCmd)
+	addOutputFormatFlag(certsRenewCmd)
+	certsCmd.AddCommand(certsStatusCmd)
+	certsCmd.AddCommand(certsRenewCmd)
+	rootCmd.AddCommand(certsCmd)
+}
+
+var certsCmd = &cobra.Command{
+	Use:   "certs SUBCOMMAND [flags]",
+	Short: "Manage the certificates of the OpenShift cluster",
+	Long:  "Show the expiry date of the certificates of the OpenShift cluster and renew the kubelet certificates",
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+var certsStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the expiry date of the cluster certificates",
+	Long: "Show the expiry date of the kubelet client and server certificates, of the API server " +
+		"serving certificate and of the ingress and client CA certificates",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCertsStatus(os.Stdout, newMachine(), outputFormat)
+	},
+}
+
+var certsRenewCmd = &cobra.Command{
+	Use:   "renew",
+	Short: "Renew the kubelet certificates",
+	Long: "Make the kubelet request new client and server certificates, approve the certificate " +
+		"signing requests and wait for the new certificates to be used",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCertsRenew(os.Stdout, newMachine(), outputFormat)
+	},
+}
+
+type certsStatusResult struct {
+	Success      bool                         `json:"success"`
+	Error        *crcErrors.SerializableError `json:"error,omitempty"`
+	Certificates []cluster.CertExpiry         `json:"certificates,omitempty"`
+}
+
+func runCertsStatus(writer io.Writer, client machine.Client, outputFormat string) error {
+	err := checkIfMachineMissing(client)
+	var certs []cluster.CertExpiry
+	if err == nil {
+		certs, err = client.CertsStatus()
+	}
+	return render(&certsStatusResult{
+		Success:      err == nil,
+		Error:        crcErrors.ToSerializableError(err),
+		Certificates: certs,
+	}, writer, outputFormat)
+}
+
+func (s *certsStatusResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	w := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(w, "NAME\tEXPIRES\tSTATUS"); err != nil {
+		return err
+	}
+	for _, cert := range s.Certificates {
+		expires, status := "", cert.Error
+		if cert.Error == "" {
+			expires = cert.NotAfter.Local().Format("2006-01-02 15:04:05")
+			status = certValidity(cert.NotAfter)
+		}
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", cert.Name, expires, status); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func certValidity(notAfter time.Time) string {
+	remaining := time.Until(notAfter)
+	if remaining <= 0 {
+		return "expired"
+	}
+	if remaining < 24*time.Hour {
+		return fmt.Sprintf("valid for %s", remaining.Round(time.Minute))
+	}
+	return fmt.Sprintf("valid for %d days", int(remaining.Hours()/24))
+}
+
+type certsRenewResult struct {
+	Success bool                         `json:"success"`
+	Error   *crcErrors.SerializableError `json:"error,omitempty"`
+}
+
+func runCertsRenew(writer io.Writer, client machine.Client, outputFormat string) error {
+	err := checkIfMachineMissing(client)
+	if err == nil {
+		err = client.RenewCerts()
+	}
+	return render(&certsRenewResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+	}, writer, outputFormat)
+}
+
+func (s *certsRenewResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	_, err := fmt.Fprintln(writer, "The kubelet certificates have been renewed")
+	return err
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertsStatusPlainSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runCertsStatus(out, fakemachine.NewClient(), ""))
+	expires := time.Date(2021, time.July, 12, 8, 0, 0, 0, time.UTC).Local().Format("2006-01-02 15:04:05")
+	assert.Equal(t, fmt.Sprintf(`NAME            EXPIRES              STATUS
+kubelet client  %s  expired
+ingress CA                           secret router-ca not found
+`, expires), out.String())
+}
+
+func TestCertsStatusPlainError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.EqualError(t, runCertsStatus(out, fakemachine.NewFailingClient(), ""), "certs status failed")
+}
+
+func TestCertsStatusJSONSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runCertsStatus(out, fakemachine.NewClient(), jsonFormat))
+	assert.JSONEq(t, `{
+  "success": true,
+  "certificates": [
+    {"name": "kubelet client", "notAfter": "2021-07-12T08:00:00Z"},
+    {"name": "ingress CA", "notAfter": "0001-01-01T00:00:00Z", "error": "secret router-ca not found"}
+  ]
+}`, out.String())
+}
+
+func TestCertsStatusJSONError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runCertsStatus(out, fakemachine.NewFailingClient(), jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "certs status failed"}`, out.String())
+}
+
+func TestCertValidity(t *testing.T) {
+	assert.Equal(t, "expired", certValidity(time.Now().Add(-time.Minute)))
+	assert.Equal(t, "valid for 2h0m0s", certValidity(time.Now().Add(2*time.Hour+10*time.Second)))
+	assert.Equal(t, "valid for 30 days", certValidity(time.Now().Add(30*24*time.Hour+time.Hour)))
+}
+
+func TestCertsRenewPlainSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runCertsRenew(out, fakemachine.NewClient(), ""))
+	assert.Equal(t, "The kubelet certificates have been renewed\n", out.String())
+}
+
+func TestCertsRenewJSONError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runCertsRenew(out, fakemachine.NewFailingClient(), jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "certs renewal failed"}`, out.String())
+}
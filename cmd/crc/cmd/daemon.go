@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -8,17 +9,27 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"reflect"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/code-ready/crc/pkg/crc/adminhelper"
 	"github.com/code-ready/crc/pkg/crc/api"
+	"github.com/code-ready/crc/pkg/crc/autostop"
 	crcConfig "github.com/code-ready/crc/pkg/crc/config"
-	"github.com/code-ready/crc/pkg/crc/constants"
 	"github.com/code-ready/crc/pkg/crc/daemonclient"
 	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	machineTypes "github.com/code-ready/crc/pkg/crc/machine/types"
+	"github.com/code-ready/crc/pkg/crc/network"
+	"github.com/code-ready/crc/pkg/crc/portforward"
 	"github.com/code-ready/crc/pkg/crc/preflight"
+	crcssh "github.com/code-ready/crc/pkg/crc/ssh"
 	"github.com/code-ready/gvisor-tap-vsock/pkg/types"
 	"github.com/code-ready/gvisor-tap-vsock/pkg/virtualnetwork"
 	"github.com/docker/go-units"
@@ -26,6 +37,7 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
 	"k8s.io/client-go/util/exec"
 )
 
@@ -36,7 +48,8 @@ func init() {
 	rootCmd.AddCommand(daemonCmd)
 }
 
-const hostVirtualIP = "192.168.127.254"
+// internalIP is the address of the node of the cluster, the bundles are built with it
+const internalIP = "192.168.126.11"
 
 var daemonCmd = &cobra.Command{
 	Use:    "daemon",
@@ -55,63 +68,140 @@ var daemonCmd = &cobra.Command{
 			}
 		}
 
+		// vsock-subnet is used once the VM has been reconfigured for it by 'crc start' or 'crc stop'
+		vsockNetwork, err := machine.InstanceVSockNetwork(instanceName)
+		if err != nil {
+			return errors.Wrap(err, "Cannot read the user mode network configuration of the VM")
+		}
 		virtualNetworkConfig := types.Configuration{
 			Debug:             false, // never log packets
 			CaptureFile:       os.Getenv("CRC_DAEMON_PCAP_FILE"),
 			MTU:               4000, // Large packets slightly improve the performance. Less small packets.
-			Subnet:            "192.168.127.0/24",
-			GatewayIP:         constants.VSockGateway,
+			Subnet:            vsockNetwork.String(),
+			GatewayIP:         vsockNetwork.Gateway().String(),
 			GatewayMacAddress: "\x5A\x94\xEF\xE4\x0C\xDD",
-			DNS: []types.Zone{
-				{
-					Name:      "apps-crc.testing.",
-					DefaultIP: net.ParseIP("192.168.127.2"),
-				},
-				{
-					Name: "crc.testing.",
-					Records: []types.Record{
-						{
-							Name: "gateway",
-							IP:   net.ParseIP("192.168.127.1"),
-						},
-						{
-							Name: "api",
-							IP:   net.ParseIP("192.168.127.2"),
-						},
-						{
-							Name: "api-int",
-							IP:   net.ParseIP("192.168.127.2"),
-						},
-						{
-							Regexp: regexp.MustCompile("crc-(.*?)-master-0"),
-							IP:     net.ParseIP("192.168.126.11"),
-						},
-					},
-				},
-			},
+			DNS: virtualNetworkZones(crcConfig.GetDomains(config), crcConfig.GetDNSRecords(config), vsockNetwork,
+				config.Get(crcConfig.HostNetworkAccess).AsBool()),
 		}
 		if config.Get(crcConfig.HostNetworkAccess).AsBool() {
 			log.Debugf("Enabling host network access")
-			for i := range virtualNetworkConfig.DNS {
-				zone := &virtualNetworkConfig.DNS[i]
-				if zone.Name != "crc.testing." {
-					continue
-				}
-				log.Debugf("Adding \"host\" -> %s DNS record to crc.testing. zone", hostVirtualIP)
-
-				zone.Records = append(zone.Records, types.Record{Name: "host", IP: net.ParseIP(hostVirtualIP)})
-			}
-
 			if virtualNetworkConfig.NAT == nil {
 				virtualNetworkConfig.NAT = make(map[string]string)
 			}
-			virtualNetworkConfig.NAT[hostVirtualIP] = "127.0.0.1"
+			virtualNetworkConfig.NAT[vsockNetwork.Host().String()] = "127.0.0.1"
 		}
-		err := run(&virtualNetworkConfig)
-		return err
+		return run(&virtualNetworkConfig)
 	},
 }
 
+// virtualNetworkZones returns the DNS zones of the apps and cluster domains of
+// the bundles, and of the custom domains set in the config, with the records of
+// dns-records. The default domains are always served since they are used inside
+// the VM.
+func virtualNetworkZones(domains network.Domains, records []network.DNSRecord, vsockNetwork network.VSockNetwork, hostNetworkAccess bool) []types.Zone {
+	appsDomains := []string{network.DefaultDomains.Apps}
+	if domains.Apps != network.DefaultDomains.Apps {
+		appsDomains = append(appsDomains, domains.Apps)
+	}
+	clusterDomains := []string{network.DefaultDomains.Cluster}
+	if domains.Cluster != network.DefaultDomains.Cluster {
+		clusterDomains = append(clusterDomains, domains.Cluster)
+	}
+
+	virtualMachineIP := vsockNetwork.VirtualMachine()
+	var zones []types.Zone
+	for _, domain := range appsDomains {
+		log.Debugf("Adding %s. DNS zone", domain)
+		zones = append(zones, types.Zone{
+			Name:      domain + ".",
+			DefaultIP: virtualMachineIP,
+		})
+	}
+	for _, domain := range clusterDomains {
+		log.Debugf("Adding %s. DNS zone", domain)
+		clusterRecords := []types.Record{
+			{
+				Name: "gateway",
+				IP:   vsockNetwork.Gateway(),
+			},
+			{
+				Name: "api",
+				IP:   virtualMachineIP,
+			},
+			{
+				Name: "api-int",
+				IP:   virtualMachineIP,
+			},
+			{
+				Regexp: regexp.MustCompile("crc-(.*?)-master-0"),
+				IP:     net.ParseIP(internalIP),
+			},
+		}
+		if hostNetworkAccess {
+			log.Debugf("Adding \"host\" -> %s DNS record to %s. zone", vsockNetwork.Host(), domain)
+			clusterRecords = append(clusterRecords, types.Record{Name: "host", IP: vsockNetwork.Host()})
+		}
+		zones = append(zones, types.Zone{
+			Name:    domain + ".",
+			Records: clusterRecords,
+		})
+	}
+	return addDNSRecords(zones, records)
+}
+
+// addDNSRecords adds the records of the dns-records setting to the zone of
+// their domain, before its other records. The other records get a zone of
+// their parent domain, the other names of this domain are not resolved by the
+// daemon then. Only IPv4 addresses are served.
+func addDNSRecords(zones []types.Zone, records []network.DNSRecord) []types.Zone {
+	var recordZones []types.Zone
+	for _, record := range records {
+		ip := net.ParseIP(record.IP).To4()
+		if ip == nil {
+			log.Warnf("Cannot serve the DNS record of %s, only IPv4 addresses are supported", record.Name)
+			continue
+		}
+		fqdn := record.Name + "."
+		if index := zoneIndex(zones, fqdn); index >= 0 {
+			name := strings.TrimSuffix(fqdn, "."+zones[index].Name)
+			zones[index].Records = append([]types.Record{{Name: name, IP: ip}}, zones[index].Records...)
+			continue
+		}
+		labels := strings.SplitN(fqdn, ".", 2)
+		if labels[1] == "" {
+			log.Warnf("Cannot serve the DNS record of %s, it has no domain", record.Name)
+			continue
+		}
+		index := -1
+		for i := range recordZones {
+			if recordZones[i].Name == labels[1] {
+				index = i
+			}
+		}
+		if index < 0 {
+			log.Debugf("Adding %s DNS zone", labels[1])
+			recordZones = append(recordZones, types.Zone{Name: labels[1]})
+			index = len(recordZones) - 1
+		}
+		recordZones[index].Records = append(recordZones[index].Records, types.Record{Name: labels[0], IP: ip})
+	}
+	// the first zone matching a name is used, the subdomains go first
+	sort.SliceStable(recordZones, func(i, j int) bool {
+		return len(recordZones[i].Name) > len(recordZones[j].Name)
+	})
+	return append(zones, recordZones...)
+}
+
+// zoneIndex returns the index of the first zone fqdn belongs to, or -1
+func zoneIndex(zones []types.Zone, fqdn string) int {
+	for i, zone := range zones {
+		if strings.HasSuffix(fqdn, "."+zone.Name) {
+			return i
+		}
+	}
+	return -1
+}
+
 func run(configuration *types.Configuration) error {
 	vsockListener, err := vsockListener()
 	if err != nil {
@@ -122,6 +212,10 @@ func run(configuration *types.Configuration) error {
 	if err != nil {
 		return err
 	}
+	vsockNetwork, err := network.ParseVSockSubnet(configuration.Subnet)
+	if err != nil {
+		return err
+	}
 
 	errCh := make(chan error)
 
@@ -130,13 +224,23 @@ func run(configuration *types.Configuration) error {
 		return err
 	}
 
+	machineClient := newMachine()
+	vmSSH := &vmSSHRunner{machineClient: machineClient}
+	// the status polls and the other requests of the clients are not activity
+	ownTraffic := autostop.NewOwnTraffic(func() uint64 {
+		return vn.BytesSent() + vn.BytesReceived()
+	})
+	autoStop := newAutoStopMonitor(machineClient, vmSSH, ownTraffic)
+	go autoStop.Run(context.Background())
+	portForwards := newPortForwardManager(machineClient, vmSSH, vn, vsockNetwork.VirtualMachine())
+
 	go func() {
 		if listener == nil {
 			return
 		}
 		mux := http.NewServeMux()
 		mux.Handle("/network/", http.StripPrefix("/network", vn.Mux()))
-		mux.Handle("/api/", http.StripPrefix("/api", api.NewMux(config, newMachine(), logging.Memory, segmentClient)))
+		mux.Handle("/api/", http.StripPrefix("/api", trackOwnTraffic(api.NewMux(config, machineClient, logging.Memory, segmentClient, autoStop, portForwards), ownTraffic)))
 		if err := http.Serve(listener, handlers.LoggingHandler(os.Stderr, mux)); err != nil {
 			errCh <- errors.Wrap(err, "api http.Serve failed")
 		}
@@ -191,6 +295,154 @@ func run(configuration *types.Configuration) error {
 	}
 }
 
+// An idle cluster still transfers a few kilobytes per minute through the
+// virtual network, this is the amount of traffic between two auto-stop checks
+// which is considered as activity.
+const networkActivityThreshold = 256 * 1024
+
+func newAutoStopMonitor(machineClient machine.Client, vmSSH *vmSSHRunner, ownTraffic *autostop.OwnTraffic) *autostop.Monitor {
+	monitor := autostop.NewMonitor(func() time.Duration {
+		duration, err := time.ParseDuration(config.Get(crcConfig.AutoStopAfter).AsString())
+		if err != nil {
+			return 0
+		}
+		return duration
+	}, machineClient.IsRunning, func() error {
+		_, err := machineClient.Stop()
+		return err
+	})
+	// the virtual network only carries the traffic of the VM in user
+	// networking mode, otherwise the network devices of the VM are read
+	vmNetworkThreshold := uint64(networkActivityThreshold)
+	if crcConfig.GetNetworkMode(config) == network.UserNetworkingMode {
+		monitor.AddActivitySource(autostop.NetworkActivity(ownTraffic.TransferredBytes, networkActivityThreshold))
+		vmNetworkThreshold = 0
+	}
+	monitor.AddActivitySource(autostop.VMActivity(func(command string) (string, error) {
+		var stdout string
+		var err error
+		ownTraffic.Track(func() {
+			stdout, err = runInVM(machineClient, vmSSH, command)
+		})
+		return stdout, err
+	}, vmNetworkThreshold))
+	return monitor
+}
+
+// trackOwnTraffic excludes the traffic of the API requests from the activity,
+// except for the long running ones which would hide the activity of the user
+func trackOwnTraffic(next http.Handler, ownTraffic *autostop.OwnTraffic) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/events" || strings.HasPrefix(r.URL.Path, "/logs/vm/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ownTraffic.Track(func() {
+			next.ServeHTTP(w, r)
+		})
+	})
+}
+
+// runInVM runs a shell command in the VM over SSH if it is running
+func runInVM(machineClient machine.Client, vmSSH *vmSSHRunner, command string) (string, error) {
+	running, err := machineClient.IsRunning()
+	if err != nil {
+		return "", err
+	}
+	if !running {
+		return "", errors.New("the VM is not running")
+	}
+	var stdout string
+	err = vmSSH.use(func(sshRunner *crcssh.Runner) error {
+		var err error
+		stdout, _, err = sshRunner.Run(command)
+		return err
+	})
+	return stdout, err
+}
+
+// vmSSHRunner keeps the SSH connection of the daemon to the VM open between
+// the commands. It is opened again when it failed or when the VM changed.
+type vmSSHRunner struct {
+	machineClient machine.Client
+
+	lock      sync.Mutex
+	sshRunner *crcssh.Runner
+	details   machineTypes.ConnectionDetails
+}
+
+func (r *vmSSHRunner) use(fun func(sshRunner *crcssh.Runner) error) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	details, err := r.machineClient.ConnectionDetails()
+	if err != nil {
+		return err
+	}
+	if r.sshRunner != nil && !reflect.DeepEqual(*details, r.details) {
+		r.closeUnlocked()
+	}
+	if r.sshRunner == nil {
+		sshRunner, err := crcssh.CreateRunner(details.IP, details.SSHPort, details.SSHKeys...)
+		if err != nil {
+			return err
+		}
+		r.sshRunner = sshRunner
+		r.details = *details
+	}
+	err = fun(r.sshRunner)
+	var exitError *ssh.ExitError
+	var openChannelError *ssh.OpenChannelError
+	if err != nil && !errors.As(err, &exitError) && !errors.As(err, &openChannelError) {
+		// the connection is broken, the VM was restarted for instance
+		r.closeUnlocked()
+	}
+	return err
+}
+
+func (r *vmSSHRunner) closeUnlocked() {
+	r.sshRunner.Close()
+	r.sshRunner = nil
+}
+
+// newPortForwardManager restores the forwards of the configuration. VM ports
+// are reached through the virtual network in user networking mode, service
+// ports are reached through the SSH connection of the daemon to the VM.
+func newPortForwardManager(machineClient machine.Client, vmSSH *vmSSHRunner, vn *virtualnetwork.VirtualNetwork, virtualMachineIP net.IP) *portforward.Manager {
+	services := portforward.NewServiceDialer()
+	manager := portforward.NewManager(func(forward portforward.Forward) (net.Conn, error) {
+		userNetworking := crcConfig.GetNetworkMode(config) == network.UserNetworkingMode
+		if forward.Service == "" && userNetworking {
+			return vn.Dial("tcp", net.JoinHostPort(virtualMachineIP.String(), strconv.Itoa(forward.VMPort)))
+		}
+		if forward.Service == "" {
+			details, err := machineClient.ConnectionDetails()
+			if err != nil {
+				return nil, err
+			}
+			return net.Dial("tcp", net.JoinHostPort(details.IP, strconv.Itoa(forward.VMPort)))
+		}
+		var conn net.Conn
+		err := vmSSH.use(func(sshRunner *crcssh.Runner) error {
+			var err error
+			conn, err = services.Dial(sshRunner, forward)
+			return err
+		})
+		return conn, err
+	})
+
+	forwards, err := portforward.ParseList(config.Get(crcConfig.PortForwards).AsString())
+	if err != nil {
+		logging.Warnf("Cannot restore the port forwards: %v", err)
+	}
+	for _, forward := range forwards {
+		if err := manager.Add(forward); err != nil {
+			logging.Warnf("Cannot restore port forward %s: %v", forward, err)
+		}
+	}
+	return manager
+}
+
 // This API is only exposed in the virtual network (only the VM can reach this).
 // Any process inside the VM can reach it by connecting to gateway.crc.testing:80.
 func gatewayAPIMux() *http.ServeMux {
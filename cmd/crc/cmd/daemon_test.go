@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/code-ready/crc/pkg/crc/network"
+	"github.com/code-ready/gvisor-tap-vsock/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func zoneNames(zones []types.Zone) []string {
+	var names []string
+	for _, zone := range zones {
+		names = append(names, zone.Name)
+	}
+	return names
+}
+
+func TestVirtualNetworkZones(t *testing.T) {
+	zones := virtualNetworkZones(network.DefaultDomains, nil, network.DefaultVSockNetwork, false)
+	assert.Equal(t, []string{"apps-crc.testing.", "crc.testing."}, zoneNames(zones))
+	assert.Equal(t, "192.168.127.2", zones[0].DefaultIP.String())
+	assert.Len(t, zones[1].Records, 4)
+
+	vsockNetwork, err := network.ParseVSockSubnet("10.10.0.0/24")
+	require.NoError(t, err)
+	domains := network.Domains{Cluster: "crc.example.lan", Apps: "apps.example.lan"}
+	zones = virtualNetworkZones(domains, nil, vsockNetwork, true)
+	assert.Equal(t, []string{"apps-crc.testing.", "apps.example.lan.", "crc.testing.", "crc.example.lan."}, zoneNames(zones))
+	assert.Equal(t, "10.10.0.2", zones[1].DefaultIP.String())
+	for _, zone := range zones[2:] {
+		require.Len(t, zone.Records, 5)
+		assert.Equal(t, "api", zone.Records[1].Name)
+		assert.Equal(t, "10.10.0.2", zone.Records[1].IP.String())
+		assert.Equal(t, "192.168.126.11", zone.Records[3].IP.String())
+		assert.Equal(t, "host", zone.Records[4].Name)
+		assert.Equal(t, "10.10.0.254", zone.Records[4].IP.String())
+	}
+}
+
+func TestVirtualNetworkZonesWithRecords(t *testing.T) {
+	records := []network.DNSRecord{
+		{Name: "registry.apps-crc.testing", IP: "10.10.2.4"},
+		{Name: "registry.example.com", IP: "10.10.2.5"},
+		{Name: "git.staging.example.com", IP: "10.10.2.6"},
+		{Name: "ipv6.example.com", IP: "fd00::1"},
+	}
+	zones := virtualNetworkZones(network.DefaultDomains, records, network.DefaultVSockNetwork, false)
+	assert.Equal(t, []string{"apps-crc.testing.", "crc.testing.", "staging.example.com.", "example.com."}, zoneNames(zones))
+
+	require.Len(t, zones[0].Records, 1)
+	assert.Equal(t, "registry", zones[0].Records[0].Name)
+	assert.Equal(t, "10.10.2.4", zones[0].Records[0].IP.String())
+	assert.Len(t, zones[1].Records, 4)
+	require.Len(t, zones[2].Records, 1)
+	assert.Equal(t, "git", zones[2].Records[0].Name)
+	require.Len(t, zones[3].Records, 1)
+	assert.Equal(t, "registry", zones[3].Records[0].Name)
+	assert.Equal(t, "10.10.2.5", zones[3].Records[0].IP.String())
+}
@@ -14,11 +14,16 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var clearCache bool
+var (
+	clearCache bool
+	purge      bool
+)
 
 func init() {
 	deleteCmd.Flags().BoolVarP(&clearCache, "clear-cache", "", false,
 		fmt.Sprintf("Clear the OpenShift cluster cache at: %s", constants.MachineCacheDir))
+	deleteCmd.Flags().BoolVarP(&purge, "purge", "", false,
+		"Also delete the persistent data disk of the instance")
 	addOutputFormatFlag(deleteCmd)
 	addForceFlag(deleteCmd)
 	rootCmd.AddCommand(deleteCmd)
@@ -29,11 +34,13 @@ var deleteCmd = &cobra.Command{
 	Short: "Delete the OpenShift cluster",
 	Long:  "Delete the OpenShift cluster",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return runDelete(os.Stdout, newMachine(), clearCache, constants.MachineCacheDir, outputFormat != jsonFormat, globalForce, outputFormat)
+		client := newMachine()
+		return runDelete(os.Stdout, client, clearCache, constants.MachineCacheDir, purge, constants.GetDataDiskPath(client.GetName()),
+			outputFormat != jsonFormat, globalForce, outputFormat)
 	},
 }
 
-func deleteMachine(client machine.Client, clearCache bool, cacheDir string, interactive, force bool) (bool, error) {
+func deleteMachine(client machine.Client, clearCache bool, cacheDir string, purge bool, dataDiskPath string, interactive, force bool) (bool, error) {
 	if clearCache {
 		if !interactive && !force {
 			return false, errors.New("non-interactive deletion requires --force")
@@ -55,13 +62,29 @@ func deleteMachine(client machine.Client, clearCache bool, cacheDir string, inte
 	yes := input.PromptUserForYesOrNo("Do you want to delete the OpenShift cluster", force)
 	if yes {
 		defer logging.BackupLogFile()
-		return true, client.Delete()
+		if err := client.Delete(); err != nil {
+			return true, err
+		}
+		return true, deleteDataDisk(purge, dataDiskPath)
 	}
 	return false, nil
 }
 
-func runDelete(writer io.Writer, client machine.Client, clearCache bool, cacheDir string, interactive, force bool, outputFormat string) error {
-	machineDeleted, err := deleteMachine(client, clearCache, cacheDir, interactive, force)
+// deleteDataDisk removes the data disk when purging, it is kept otherwise so
+// that the persistent volumes can be reused by the next instance
+func deleteDataDisk(purge bool, dataDiskPath string) error {
+	if _, err := os.Stat(dataDiskPath); err != nil {
+		return nil
+	}
+	if !purge {
+		logging.Infof("The data disk %s was kept, use 'crc delete --purge' to delete it", dataDiskPath)
+		return nil
+	}
+	return os.Remove(dataDiskPath)
+}
+
+func runDelete(writer io.Writer, client machine.Client, clearCache bool, cacheDir string, purge bool, dataDiskPath string, interactive, force bool, outputFormat string) error {
+	machineDeleted, err := deleteMachine(client, clearCache, cacheDir, purge, dataDiskPath, interactive, force)
 	return render(&deleteResult{
 		Success:        err == nil,
 		Error:          crcErrors.ToSerializableError(err),
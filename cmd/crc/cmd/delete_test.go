@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
@@ -17,7 +18,7 @@ func TestPlainDelete(t *testing.T) {
 	defer os.RemoveAll(cacheDir)
 
 	out := new(bytes.Buffer)
-	assert.NoError(t, runDelete(out, fakemachine.NewClient(), true, cacheDir, true, true, ""))
+	assert.NoError(t, runDelete(out, fakemachine.NewClient(), true, cacheDir, false, "", true, true, ""))
 	assert.Equal(t, "Deleted the OpenShift cluster\n", out.String())
 
 	_, err = os.Stat(cacheDir)
@@ -30,7 +31,7 @@ func TestNonForceDelete(t *testing.T) {
 	defer os.RemoveAll(cacheDir)
 
 	out := new(bytes.Buffer)
-	assert.NoError(t, runDelete(out, fakemachine.NewClient(), true, cacheDir, true, false, ""))
+	assert.NoError(t, runDelete(out, fakemachine.NewClient(), true, cacheDir, false, "", true, false, ""))
 	assert.Equal(t, "", out.String())
 
 	_, err = os.Stat(cacheDir)
@@ -43,9 +44,26 @@ func TestJSONDelete(t *testing.T) {
 	defer os.RemoveAll(cacheDir)
 
 	out := new(bytes.Buffer)
-	assert.NoError(t, runDelete(out, fakemachine.NewClient(), true, cacheDir, false, true, jsonFormat))
+	assert.NoError(t, runDelete(out, fakemachine.NewClient(), true, cacheDir, false, "", false, true, jsonFormat))
 	assert.JSONEq(t, `{"success": true}`, out.String())
 
 	_, err = os.Stat(cacheDir)
 	assert.True(t, os.IsNotExist(err))
 }
+
+func TestDeleteDataDisk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "disks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	dataDiskPath := filepath.Join(dir, "crc-data.qcow2")
+	require.NoError(t, ioutil.WriteFile(dataDiskPath, []byte("disk"), 0600))
+
+	out := new(bytes.Buffer)
+	assert.NoError(t, runDelete(out, fakemachine.NewClient(), false, "", false, dataDiskPath, true, true, ""))
+	_, err = os.Stat(dataDiskPath)
+	assert.NoError(t, err)
+
+	assert.NoError(t, runDelete(out, fakemachine.NewClient(), false, "", true, dataDiskPath, true, true, ""))
+	_, err = os.Stat(dataDiskPath)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	crcConfig "github.com/code-ready/crc/pkg/crc/config"
+	"github.com/code-ready/crc/pkg/crc/diagnose"
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/spf13/cobra"
+)
+
+var diagnoseFile string
+
+func init() {
+	diagnoseCmd.Flags().StringVar(&diagnoseFile, "file", "", "Path of the support bundle (default: crc-diagnose-<date>.tar.zst in the current directory)")
+	addOutputFormatFlag(diagnoseCmd)
+	rootCmd.AddCommand(diagnoseCmd)
+}
+
+var diagnoseCmd = &cobra.Command{
+	Use:   "diagnose",
+	Short: "Collect the information needed to debug a CodeReady Containers setup",
+	Long: "Write a support bundle with the logs, the configuration, the preflight check results and, " +
+		"when the VM is running, the journals of its services and the state of the cluster operators. " +
+		"The pull secret and the passwords are redacted.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := diagnoseFile
+		if path == "" {
+			path = fmt.Sprintf("crc-diagnose-%s.tar.zst", time.Now().Format("20060102150405"))
+		}
+		return runDiagnose(os.Stdout, config, newMachine(), path, outputFormat)
+	},
+}
+
+type diagnoseResult struct {
+	Success bool                         `json:"success"`
+	Error   *crcErrors.SerializableError `json:"error,omitempty"`
+	File    string                       `json:"file,omitempty"`
+}
+
+func runDiagnose(writer io.Writer, config crcConfig.Storage, client machine.Client, path string, outputFormat string) error {
+	path, err := filepath.Abs(path)
+	if err == nil {
+		err = diagnose.Collect(context.Background(), config, client, path)
+	}
+	result := &diagnoseResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+	}
+	if err == nil {
+		result.File = path
+	}
+	return render(result, writer, outputFormat)
+}
+
+func (s *diagnoseResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	_, err := fmt.Fprintf(writer, "Support bundle written to %s\n", s.File)
+	return err
+}
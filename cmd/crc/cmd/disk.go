@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	addOutputFormatFlag(diskCompactCmd)
+	diskCmd.AddCommand(diskCompactCmd)
+	rootCmd.AddCommand(diskCmd)
+}
+
+var diskCmd = &cobra.Command{
+	Use:   "disk SUBCOMMAND [flags]",
+	Short: "Manage the disk of the CodeReady Containers VM",
+	Long:  "Manage the disk image of the CodeReady Containers VM",
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+var diskCompactCmd = &cobra.Command{
+	Use:   "compact",
+	Short: "Reclaim the unused space of the VM disk image",
+	Long: "Trim the filesystems of the running VM, stop it and rewrite its disk image " +
+		"to give the space of the deleted files back to the host",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDiskCompact(os.Stdout, newMachine(), outputFormat)
+	},
+}
+
+type diskCompactResult struct {
+	Success        bool                         `json:"success"`
+	Error          *crcErrors.SerializableError `json:"error,omitempty"`
+	ReclaimedBytes int64                        `json:"reclaimedBytes"`
+}
+
+func runDiskCompact(writer io.Writer, client machine.Client, outputFormat string) error {
+	err := checkIfMachineMissing(client)
+	var reclaimed int64
+	if err == nil {
+		reclaimed, err = client.CompactDisk()
+	}
+	return render(&diskCompactResult{
+		Success:        err == nil,
+		Error:          crcErrors.ToSerializableError(err),
+		ReclaimedBytes: reclaimed,
+	}, writer, outputFormat)
+}
+
+func (s *diskCompactResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	_, err := fmt.Fprintf(writer, "Reclaimed %s of disk space, use 'crc start' to start the instance again\n",
+		units.HumanSize(float64(s.ReclaimedBytes)))
+	return err
+}
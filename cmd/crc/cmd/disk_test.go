@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiskCompactPlainSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runDiskCompact(out, fakemachine.NewClient(), ""))
+	assert.Equal(t, "Reclaimed 1.5GB of disk space, use 'crc start' to start the instance again\n", out.String())
+}
+
+func TestDiskCompactPlainError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.EqualError(t, runDiskCompact(out, fakemachine.NewFailingClient(), ""), "compact failed")
+}
+
+func TestDiskCompactJSONSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runDiskCompact(out, fakemachine.NewClient(), jsonFormat))
+	assert.JSONEq(t, `{"success": true, "reclaimedBytes": 1500000000}`, out.String())
+}
+
+func TestDiskCompactJSONError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runDiskCompact(out, fakemachine.NewFailingClient(), jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "compact failed", "reclaimedBytes": 0}`, out.String())
+}
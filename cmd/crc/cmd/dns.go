@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	crcConfig "github.com/code-ready/crc/pkg/crc/config"
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/code-ready/crc/pkg/crc/network"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{dnsAddRecordCmd, dnsListCmd, dnsRemoveCmd} {
+		addOutputFormatFlag(cmd)
+		dnsCmd.AddCommand(cmd)
+	}
+	rootCmd.AddCommand(dnsCmd)
+}
+
+var dnsCmd = &cobra.Command{
+	Use:   "dns SUBCOMMAND [flags]",
+	Short: "Manage the DNS records served to the VM",
+	Long: "Manage the address records served by the DNS server of the VM, they are resolved by the VM and by the pods. " +
+		"The records are stored in the '" + crcConfig.DNSRecords + "' setting, the queries for the other names are forwarded " +
+		"to the DNS servers of the '" + crcConfig.UpstreamDNS + "' setting when it is set. " +
+		"In user network mode, the records are served by the daemon, which must be restarted, and the other names of the domain of a record are not resolved.",
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+var dnsAddRecordCmd = &cobra.Command{
+	Use:   "add-record NAME IP",
+	Short: "Add an address record",
+	Long:  "Add an address record, or replace the record with the same name (for example 'crc dns add-record registry.staging.example.com 10.10.2.5')",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDNSAddRecord(os.Stdout, config, newMachine(), args[0], args[1], outputFormat)
+	},
+}
+
+var dnsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the address records and the upstream DNS servers",
+	Long:  "List the address records and the upstream DNS servers",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDNSList(os.Stdout, config, outputFormat)
+	},
+}
+
+var dnsRemoveCmd = &cobra.Command{
+	Use:   "remove NAME",
+	Short: "Remove an address record",
+	Long:  "Remove an address record",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDNSRemove(os.Stdout, config, newMachine(), args[0], outputFormat)
+	},
+}
+
+type dnsResult struct {
+	Success bool                         `json:"success"`
+	Error   *crcErrors.SerializableError `json:"error,omitempty"`
+	Message string                       `json:"-"`
+	running bool
+}
+
+func (s *dnsResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	if s.running {
+		_, err := fmt.Fprintf(writer, "%s, run 'crc stop' and 'crc start' to apply the change\n", s.Message)
+		return err
+	}
+	_, err := fmt.Fprintf(writer, "%s, the change is applied by 'crc start'\n", s.Message)
+	return err
+}
+
+func addDNSRecord(config crcConfig.Storage, name, ip string) (network.DNSRecord, error) {
+	record, err := network.NewDNSRecord(name, ip)
+	if err != nil {
+		return network.DNSRecord{}, err
+	}
+	records := []network.DNSRecord{}
+	for _, existing := range crcConfig.GetDNSRecords(config) {
+		if existing.Name != record.Name {
+			records = append(records, existing)
+		}
+	}
+	records = append(records, record)
+	if _, err := config.Set(crcConfig.DNSRecords, network.FormatDNSRecords(records)); err != nil {
+		return network.DNSRecord{}, err
+	}
+	return record, nil
+}
+
+func runDNSAddRecord(writer io.Writer, config crcConfig.Storage, client machine.Client, name, ip, outputFormat string) error {
+	record, err := addDNSRecord(config, name, ip)
+	running, _ := client.IsRunning()
+	return render(&dnsResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+		Message: fmt.Sprintf("%s resolves to %s in the VM", record.Name, record.IP),
+		running: running,
+	}, writer, outputFormat)
+}
+
+func removeDNSRecord(config crcConfig.Storage, name string) error {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	records := []network.DNSRecord{}
+	found := false
+	for _, existing := range crcConfig.GetDNSRecords(config) {
+		if existing.Name == name {
+			found = true
+			continue
+		}
+		records = append(records, existing)
+	}
+	if !found {
+		return fmt.Errorf("no record for '%s'", name)
+	}
+	if len(records) == 0 {
+		_, err := config.Unset(crcConfig.DNSRecords)
+		return err
+	}
+	_, err := config.Set(crcConfig.DNSRecords, network.FormatDNSRecords(records))
+	return err
+}
+
+func runDNSRemove(writer io.Writer, config crcConfig.Storage, client machine.Client, name, outputFormat string) error {
+	err := removeDNSRecord(config, name)
+	running, _ := client.IsRunning()
+	return render(&dnsResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+		Message: fmt.Sprintf("Removed the record of %s", name),
+		running: running,
+	}, writer, outputFormat)
+}
+
+type dnsListResult struct {
+	Success         bool                         `json:"success"`
+	Error           *crcErrors.SerializableError `json:"error,omitempty"`
+	Records         []network.DNSRecord          `json:"records"`
+	UpstreamServers []string                     `json:"upstreamServers"`
+}
+
+func runDNSList(writer io.Writer, config crcConfig.Storage, outputFormat string) error {
+	records := crcConfig.GetDNSRecords(config)
+	if records == nil {
+		records = []network.DNSRecord{}
+	}
+	servers := crcConfig.GetUpstreamDNS(config)
+	if servers == nil {
+		servers = []string{}
+	}
+	return render(&dnsListResult{
+		Success:         true,
+		Records:         records,
+		UpstreamServers: servers,
+	}, writer, outputFormat)
+}
+
+func (s *dnsListResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	if len(s.Records) == 0 {
+		if _, err := fmt.Fprintln(writer, "No DNS records"); err != nil {
+			return err
+		}
+	} else {
+		w := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+		if _, err := fmt.Fprintln(w, "NAME\tIP"); err != nil {
+			return err
+		}
+		for _, record := range s.Records {
+			if _, err := fmt.Fprintf(w, "%s\t%s\n", record.Name, record.IP); err != nil {
+				return err
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	upstream := "the DNS servers of the host"
+	if len(s.UpstreamServers) > 0 {
+		upstream = strings.Join(s.UpstreamServers, ", ")
+	}
+	_, err := fmt.Fprintf(writer, "Upstream DNS servers: %s\n", upstream)
+	return err
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	crcConfig "github.com/code-ready/crc/pkg/crc/config"
+	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConfig() *crcConfig.Config {
+	config := crcConfig.New(crcConfig.NewEmptyInMemoryStorage())
+	crcConfig.RegisterSettings(config)
+	return config
+}
+
+func TestDNSAddRecord(t *testing.T) {
+	config := newTestConfig()
+
+	out := new(bytes.Buffer)
+	assert.NoError(t, runDNSAddRecord(out, config, fakemachine.NewClient(), "Registry.Staging.Example.com.", "10.10.2.5", ""))
+	assert.Equal(t, "registry.staging.example.com resolves to 10.10.2.5 in the VM, run 'crc stop' and 'crc start' to apply the change\n", out.String())
+
+	out.Reset()
+	assert.NoError(t, runDNSAddRecord(out, config, fakemachine.NewClient(), "git.staging.example.com", "fd00::5", jsonFormat))
+	assert.JSONEq(t, `{"success": true}`, out.String())
+
+	// adding a record with the same name replaces the previous one
+	assert.NoError(t, runDNSAddRecord(new(bytes.Buffer), config, fakemachine.NewClient(), "registry.staging.example.com", "10.10.2.6", jsonFormat))
+	assert.Equal(t, "git.staging.example.com=fd00::5,registry.staging.example.com=10.10.2.6", config.Get(crcConfig.DNSRecords).AsString())
+}
+
+func TestDNSAddRecordInvalidIP(t *testing.T) {
+	config := newTestConfig()
+	out := new(bytes.Buffer)
+	assert.NoError(t, runDNSAddRecord(out, config, fakemachine.NewClient(), "registry", "10.10.2", jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "'10.10.2' is not a valid IP address"}`, out.String())
+	assert.True(t, config.Get(crcConfig.DNSRecords).IsDefault)
+}
+
+func TestDNSRemove(t *testing.T) {
+	config := newTestConfig()
+	_, err := config.Set(crcConfig.DNSRecords, "registry=10.10.2.5,git=10.10.2.6")
+	require.NoError(t, err)
+
+	out := new(bytes.Buffer)
+	assert.NoError(t, runDNSRemove(out, config, fakemachine.NewClient(), "registry", ""))
+	assert.Equal(t, "Removed the record of registry, run 'crc stop' and 'crc start' to apply the change\n", out.String())
+	assert.Equal(t, "git=10.10.2.6", config.Get(crcConfig.DNSRecords).AsString())
+
+	out.Reset()
+	assert.NoError(t, runDNSRemove(out, config, fakemachine.NewClient(), "registry", jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "no record for 'registry'"}`, out.String())
+
+	assert.NoError(t, runDNSRemove(new(bytes.Buffer), config, fakemachine.NewClient(), "git", jsonFormat))
+	assert.True(t, config.Get(crcConfig.DNSRecords).IsDefault)
+}
+
+func TestDNSList(t *testing.T) {
+	config := newTestConfig()
+
+	out := new(bytes.Buffer)
+	assert.NoError(t, runDNSList(out, config, ""))
+	assert.Equal(t, "No DNS records\nUpstream DNS servers: the DNS servers of the host\n", out.String())
+
+	_, err := config.Set(crcConfig.DNSRecords, "registry.staging.example.com=10.10.2.5,git=10.10.2.6")
+	require.NoError(t, err)
+	_, err = config.Set(crcConfig.UpstreamDNS, "10.10.0.53, 10.10.0.54")
+	require.NoError(t, err)
+
+	out.Reset()
+	assert.NoError(t, runDNSList(out, config, ""))
+	assert.Equal(t, `NAME                          IP
+registry.staging.example.com  10.10.2.5
+git                           10.10.2.6
+Upstream DNS servers: 10.10.0.53, 10.10.0.54
+`, out.String())
+
+	out.Reset()
+	assert.NoError(t, runDNSList(out, config, jsonFormat))
+	assert.JSONEq(t, `{
+  "success": true,
+  "records": [
+    {"name": "registry.staging.example.com", "ip": "10.10.2.5"},
+    {"name": "git", "ip": "10.10.2.6"}
+  ],
+  "upstreamServers": ["10.10.0.53", "10.10.0.54"]
+}`, out.String())
+}
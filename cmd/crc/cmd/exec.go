@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(execCmd)
+}
+
+var execCmd = &cobra.Command{
+	Use:   "exec -- COMMAND [ARGS...]",
+	Short: "Run a command in the OpenShift cluster VM",
+	Long: "Run a command in the CodeReady Containers VM. Its standard output and error are forwarded, " +
+		"and crc exits with its exit code.",
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runExec(newMachine(), args, os.Stdin, os.Stdout, os.Stderr)
+	},
+}
+
+func runExec(client machine.Client, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	sshRunner, err := createVMRunner(client)
+	if err != nil {
+		return err
+	}
+	defer sshRunner.Close()
+
+	return remoteExitStatus(sshRunner.Stream(shellJoin(args), stdin, stdout, stderr))
+}
+
+var shellSafe = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// shellJoin quotes the arguments for the shell which runs the command in the VM
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if shellSafe.MatchString(arg) {
+			quoted[i] = arg
+		} else {
+			quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'"'"'`) + "'"
+		}
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShellJoin(t *testing.T) {
+	assert.Equal(t, "journalctl -u kubelet --since=-10m", shellJoin([]string{"journalctl", "-u", "kubelet", "--since=-10m"}))
+	assert.Equal(t, `sh -c 'crictl ps | grep etcd'`, shellJoin([]string{"sh", "-c", "crictl ps | grep etcd"}))
+	assert.Equal(t, `echo 'it'"'"'s' ''`, shellJoin([]string{"echo", "it's", ""}))
+}
+
+func TestExecFailure(t *testing.T) {
+	assert.EqualError(t, runExec(fakemachine.NewFailingClient(), []string{"crictl", "ps"}, nil, nil, nil), "not implemented")
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/code-ready/crc/pkg/crc/machine/types"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	addOutputFormatFlag(listCmd)
+	rootCmd.AddCommand(listCmd)
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the CodeReady Containers instances",
+	Long:  "List all the CodeReady Containers instances along with their state",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runList(os.Stdout, machine.ListInstances, outputFormat)
+	},
+}
+
+type instance struct {
+	Name             string `json:"name"`
+	State            string `json:"state"`
+	OpenShiftVersion string `json:"openshiftVersion,omitempty"`
+	Bundle           string `json:"bundle,omitempty"`
+}
+
+type listResult struct {
+	Success   bool                         `json:"success"`
+	Error     *crcErrors.SerializableError `json:"error,omitempty"`
+	Instances []instance                   `json:"instances"`
+}
+
+func runList(writer io.Writer, listInstances func() ([]types.InstanceDetails, error), outputFormat string) error {
+	details, err := listInstances()
+	if err != nil {
+		return render(&listResult{Success: false, Error: crcErrors.ToSerializableError(err), Instances: []instance{}}, writer, outputFormat)
+	}
+	instances := []instance{}
+	for _, detail := range details {
+		instances = append(instances, instance{
+			Name:             detail.Name,
+			State:            string(detail.State),
+			OpenShiftVersion: detail.OpenshiftVersion,
+			Bundle:           detail.Bundle,
+		})
+	}
+	return render(&listResult{Success: true, Instances: instances}, writer, outputFormat)
+}
+
+func (s *listResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	if len(s.Instances) == 0 {
+		_, err := fmt.Fprintln(writer, "No instances found, use 'crc start' to create one")
+		return err
+	}
+	w := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(w, "NAME\tSTATE\tOPENSHIFT\tBUNDLE"); err != nil {
+		return err
+	}
+	for _, instance := range s.Instances {
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", instance.Name, instance.State, instance.OpenShiftVersion, instance.Bundle); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
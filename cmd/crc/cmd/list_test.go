@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/code-ready/crc/pkg/crc/machine/state"
+	"github.com/code-ready/crc/pkg/crc/machine/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func listTwoInstances() ([]types.InstanceDetails, error) {
+	return []types.InstanceDetails{
+		{
+			Name:             "crc",
+			State:            state.Running,
+			OpenshiftVersion: "4.7.2",
+			Bundle:           "crc_libvirt_4.7.2",
+		},
+		{
+			Name:             "older",
+			State:            state.Stopped,
+			OpenshiftVersion: "4.6.15",
+			Bundle:           "crc_libvirt_4.6.15",
+		},
+	}, nil
+}
+
+func TestPlainList(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runList(out, listTwoInstances, ""))
+
+	expected := `NAME   STATE    OPENSHIFT  BUNDLE
+crc    Running  4.7.2      crc_libvirt_4.7.2
+older  Stopped  4.6.15     crc_libvirt_4.6.15
+`
+	assert.Equal(t, expected, out.String())
+}
+
+func TestJsonList(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runList(out, listTwoInstances, jsonFormat))
+
+	expected := `{
+  "success": true,
+  "instances": [
+    {
+      "name": "crc",
+      "state": "Running",
+      "openshiftVersion": "4.7.2",
+      "bundle": "crc_libvirt_4.7.2"
+    },
+    {
+      "name": "older",
+      "state": "Stopped",
+      "openshiftVersion": "4.6.15",
+      "bundle": "crc_libvirt_4.6.15"
+    }
+  ]
+}
+`
+	assert.Equal(t, expected, out.String())
+}
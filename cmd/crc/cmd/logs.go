@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/spf13/cobra"
+)
+
+var (
+	logsFollow bool
+	logsSince  time.Duration
+)
+
+func init() {
+	logsCmd.Flags().BoolVarP(&logsFollow, "follow", "f", false, "Keep printing the new log entries")
+	logsCmd.Flags().DurationVar(&logsSince, "since", 0, "Only print the log entries newer than the given duration (e.g. 10m, 2h)")
+	rootCmd.AddCommand(logsCmd)
+}
+
+var logsCmd = &cobra.Command{
+	Use:   "logs [kubelet|crio|dnsmasq|UNIT]",
+	Short: "Print the logs of a service of the OpenShift cluster VM",
+	Long: "Print the systemd journal of a service of the CodeReady Containers VM. " +
+		"The kubelet logs are printed when no service is given.",
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		unit := "kubelet"
+		if len(args) == 1 {
+			unit = args[0]
+		}
+		return runLogs(context.Background(), os.Stdout, newMachine(), unit, logsSince, logsFollow)
+	},
+}
+
+func runLogs(ctx context.Context, writer io.Writer, client machine.Client, unit string, since time.Duration, follow bool) error {
+	if err := checkIfMachineMissing(client); err != nil {
+		return err
+	}
+	return client.VMLogs(ctx, unit, since, follow, writer)
+}
@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogs(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runLogs(context.Background(), out, fakemachine.NewClient(), "crio", time.Hour, false))
+	assert.Equal(t, "crio started\n", out.String())
+}
+
+func TestLogsFailure(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.EqualError(t, runLogs(context.Background(), out, fakemachine.NewFailingClient(), "crio", 0, true), "logs failed")
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	crcConfig "github.com/code-ready/crc/pkg/crc/config"
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	addOutputFormatFlag(mountCmd)
+	rootCmd.AddCommand(mountCmd)
+}
+
+var mountCmd = &cobra.Command{
+	Use:   "mount HOST-PATH VM-PATH",
+	Short: "Share a host directory with the VM",
+	Long: "Share a host directory with the VM, it is mounted at VM-PATH when the VM starts and can be used as a hostPath volume. " +
+		"The directory is not mounted in a running VM, it must be stopped and started again. " +
+		"The shared directories are stored in the '" + crcConfig.SharedDirs + "' setting. " +
+		"Only Linux is supported, the directories are shared with virtiofs.",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMount(os.Stdout, config, newMachine(), args[0], args[1], outputFormat)
+	},
+}
+
+type mountResult struct {
+	Success bool                         `json:"success"`
+	Error   *crcErrors.SerializableError `json:"error,omitempty"`
+	Source  string                       `json:"source,omitempty"`
+	Target  string                       `json:"target,omitempty"`
+	running bool
+}
+
+func addSharedDir(config crcConfig.Storage, source, target string) (crcConfig.SharedDir, error) {
+	if runtime.GOOS != "linux" {
+		return crcConfig.SharedDir{}, fmt.Errorf("Sharing host directories is not supported on %s", runtime.GOOS)
+	}
+	source, err := filepath.Abs(source)
+	if err != nil {
+		return crcConfig.SharedDir{}, err
+	}
+	newDirs, err := crcConfig.ParseSharedDirs(fmt.Sprintf("%s:%s", source, target))
+	if err != nil {
+		return crcConfig.SharedDir{}, err
+	}
+	dir := newDirs[0]
+
+	dirs := []crcConfig.SharedDir{}
+	for _, existing := range crcConfig.GetSharedDirs(config) {
+		if existing.Target != dir.Target {
+			dirs = append(dirs, existing)
+		}
+	}
+	dirs = append(dirs, dir)
+	if _, err := config.Set(crcConfig.SharedDirs, crcConfig.FormatSharedDirs(dirs)); err != nil {
+		return crcConfig.SharedDir{}, err
+	}
+	return dir, nil
+}
+
+func runMount(writer io.Writer, config crcConfig.Storage, client machine.Client, source, target, outputFormat string) error {
+	dir, err := addSharedDir(config, source, target)
+	running, _ := client.IsRunning()
+	return render(&mountResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+		Source:  dir.Source,
+		Target:  dir.Target,
+		running: running,
+	}, writer, outputFormat)
+}
+
+func (s *mountResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	if s.running {
+		_, err := fmt.Fprintf(writer, "%s will be mounted at %s in the VM, run 'crc stop' and 'crc start' to mount it\n", s.Source, s.Target)
+		return err
+	}
+	_, err := fmt.Fprintf(writer, "%s will be mounted at %s in the VM by 'crc start'\n", s.Source, s.Target)
+	return err
+}
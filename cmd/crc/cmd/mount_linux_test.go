@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	crcConfig "github.com/code-ready/crc/pkg/crc/config"
+	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMount(t *testing.T) {
+	dir, err := ioutil.TempDir("", "src")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	config := newTestConfig()
+
+	out := new(bytes.Buffer)
+	assert.NoError(t, runMount(out, config, fakemachine.NewClient(), dir, "/mnt/src", ""))
+	assert.Equal(t, dir+" will be mounted at /mnt/src in the VM, run 'crc stop' and 'crc start' to mount it\n", out.String())
+
+	out.Reset()
+	assert.NoError(t, runMount(out, config, fakemachine.NewClient(), dir, "/mnt/fixtures/", jsonFormat))
+	assert.JSONEq(t, `{"success": true, "source": "`+dir+`", "target": "/mnt/fixtures"}`, out.String())
+
+	// mounting again at the same VM path replaces the previous share
+	assert.NoError(t, runMount(new(bytes.Buffer), config, fakemachine.NewClient(), dir, "/mnt/src", jsonFormat))
+	assert.Equal(t, dir+":/mnt/fixtures,"+dir+":/mnt/src", config.Get(crcConfig.SharedDirs).AsString())
+}
+
+func TestMountMissingDirectory(t *testing.T) {
+	config := newTestConfig()
+	out := new(bytes.Buffer)
+	assert.NoError(t, runMount(out, config, fakemachine.NewClient(), "/nonexistent", "/mnt/src", jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "Value '/nonexistent:/mnt/src' for configuration property 'shared-dirs' is invalid, reason: host directory '/nonexistent' does not exist"}`, out.String())
+	assert.True(t, config.Get(crcConfig.SharedDirs).IsDefault)
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	addOutputFormatFlag(pauseCmd)
+	rootCmd.AddCommand(pauseCmd)
+}
+
+var pauseCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Pause the OpenShift cluster",
+	Long:  "Suspend the running CodeReady Containers VM, its memory is kept so that the OpenShift cluster does not need to start again on resume",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runPause(os.Stdout, newMachine(), outputFormat)
+	},
+}
+
+func runPause(writer io.Writer, client machine.Client, outputFormat string) error {
+	err := checkIfMachineMissing(client)
+	if err == nil {
+		err = client.Pause()
+	}
+	return render(&pauseResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+	}, writer, outputFormat)
+}
+
+type pauseResult struct {
+	Success bool                         `json:"success"`
+	Error   *crcErrors.SerializableError `json:"error,omitempty"`
+}
+
+func (s *pauseResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	_, err := fmt.Fprintln(writer, "Paused the OpenShift cluster")
+	return err
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPausePlainSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runPause(out, fakemachine.NewClient(), ""))
+	assert.Equal(t, "Paused the OpenShift cluster\n", out.String())
+}
+
+func TestPausePlainError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.EqualError(t, runPause(out, fakemachine.NewFailingClient(), ""), "pause failed")
+}
+
+func TestPauseJSONSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runPause(out, fakemachine.NewClient(), jsonFormat))
+	assert.JSONEq(t, `{"success": true}`, out.String())
+}
+
+func TestPauseJSONError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runPause(out, fakemachine.NewFailingClient(), jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "pause failed"}`, out.String())
+}
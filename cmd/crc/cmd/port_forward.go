@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	apiClient "github.com/code-ready/crc/pkg/crc/api/client"
+	"github.com/code-ready/crc/pkg/crc/daemonclient"
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/portforward"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{portForwardAddCmd, portForwardListCmd, portForwardRemoveCmd} {
+		addOutputFormatFlag(cmd)
+		portForwardCmd.AddCommand(cmd)
+	}
+	rootCmd.AddCommand(portForwardCmd)
+}
+
+var portForwardCmd = &cobra.Command{
+	Use:   "port-forward SUBCOMMAND [flags]",
+	Short: "Forward localhost ports to the VM or to cluster services",
+	Long: "Forward localhost ports to ports of the VM or to ports of services of the cluster. " +
+		"The forwards are handled by the daemon and are restored when it starts.",
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+var portForwardAddCmd = &cobra.Command{
+	Use:   "add HOST-PORT VM-PORT|NAMESPACE/SERVICE:PORT",
+	Short: "Forward a localhost port",
+	Long: "Forward a localhost port to a port of the VM, or to a port of a service of the cluster " +
+		"(for example 'crc port-forward add 15432 db/postgres:5432')",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runPortForwardAdd(os.Stdout, daemonclient.New().APIClient, args[0], args[1], outputFormat)
+	},
+}
+
+var portForwardListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the forwarded localhost ports",
+	Long:  "List the forwarded localhost ports",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runPortForwardList(os.Stdout, daemonclient.New().APIClient, outputFormat)
+	},
+}
+
+var portForwardRemoveCmd = &cobra.Command{
+	Use:   "remove HOST-PORT",
+	Short: "Stop forwarding a localhost port",
+	Long:  "Stop forwarding a localhost port",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runPortForwardRemove(os.Stdout, daemonclient.New().APIClient, args[0], outputFormat)
+	},
+}
+
+type portForwardClient interface {
+	PortForwards() (apiClient.PortForwardsResult, error)
+	AddPortForward(forward portforward.Forward) error
+	RemovePortForward(hostPort int) error
+}
+
+type portForwardResult struct {
+	Success bool                         `json:"success"`
+	Error   *crcErrors.SerializableError `json:"error,omitempty"`
+	Message string                       `json:"-"`
+}
+
+func (s *portForwardResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	_, err := fmt.Fprintln(writer, s.Message)
+	return err
+}
+
+func runPortForwardAdd(writer io.Writer, client portForwardClient, hostPort, target, outputFormat string) error {
+	forward, err := portforward.Parse(fmt.Sprintf("%s:%s", hostPort, target))
+	if err == nil {
+		err = client.AddPortForward(forward)
+	}
+	return render(&portForwardResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+		Message: fmt.Sprintf("Forwarding localhost:%s to %s", hostPort, target),
+	}, writer, outputFormat)
+}
+
+func runPortForwardRemove(writer io.Writer, client portForwardClient, hostPort, outputFormat string) error {
+	port, err := strconv.Atoi(hostPort)
+	if err != nil {
+		err = fmt.Errorf("'%s' is not a valid port number", hostPort)
+	} else {
+		err = client.RemovePortForward(port)
+	}
+	return render(&portForwardResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+		Message: fmt.Sprintf("Stopped forwarding localhost:%s", hostPort),
+	}, writer, outputFormat)
+}
+
+type portForwardListResult struct {
+	Success  bool                         `json:"success"`
+	Error    *crcErrors.SerializableError `json:"error,omitempty"`
+	Forwards []portforward.Forward        `json:"forwards"`
+}
+
+func runPortForwardList(writer io.Writer, client portForwardClient, outputFormat string) error {
+	result, err := client.PortForwards()
+	if err != nil {
+		return render(&portForwardListResult{Success: false, Error: crcErrors.ToSerializableError(err), Forwards: []portforward.Forward{}}, writer, outputFormat)
+	}
+	forwards := result.Forwards
+	if forwards == nil {
+		forwards = []portforward.Forward{}
+	}
+	return render(&portForwardListResult{Success: true, Forwards: forwards}, writer, outputFormat)
+}
+
+func (s *portForwardListResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	if len(s.Forwards) == 0 {
+		_, err := fmt.Fprintln(writer, "No forwarded ports")
+		return err
+	}
+	w := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(w, "HOST PORT\tTARGET"); err != nil {
+		return err
+	}
+	for _, forward := range s.Forwards {
+		target := fmt.Sprintf("VM port %d", forward.VMPort)
+		if forward.Service != "" {
+			target = fmt.Sprintf("service %s", forward.Target())
+		}
+		if _, err := fmt.Fprintf(w, "%d\t%s\n", forward.HostPort, target); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
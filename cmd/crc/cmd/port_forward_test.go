@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	apiClient "github.com/code-ready/crc/pkg/crc/api/client"
+	"github.com/code-ready/crc/pkg/crc/portforward"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakePortForwardClient struct {
+	forwards []portforward.Forward
+	failing  bool
+}
+
+func (c *fakePortForwardClient) PortForwards() (apiClient.PortForwardsResult, error) {
+	if c.failing {
+		return apiClient.PortForwardsResult{}, errors.New("daemon not running")
+	}
+	return apiClient.PortForwardsResult{Success: true, Forwards: c.forwards}, nil
+}
+
+func (c *fakePortForwardClient) AddPortForward(forward portforward.Forward) error {
+	if c.failing {
+		return errors.New("daemon not running")
+	}
+	c.forwards = append(c.forwards, forward)
+	return nil
+}
+
+func (c *fakePortForwardClient) RemovePortForward(hostPort int) error {
+	if c.failing {
+		return errors.New("daemon not running")
+	}
+	c.forwards = nil
+	return nil
+}
+
+func TestPortForwardAdd(t *testing.T) {
+	client := &fakePortForwardClient{}
+	out := new(bytes.Buffer)
+	assert.NoError(t, runPortForwardAdd(out, client, "15432", "db/postgres:5432", ""))
+	assert.Equal(t, "Forwarding localhost:15432 to db/postgres:5432\n", out.String())
+	assert.Equal(t, []portforward.Forward{{HostPort: 15432, Namespace: "db", Service: "postgres", ServicePort: 5432}}, client.forwards)
+
+	out.Reset()
+	assert.NoError(t, runPortForwardAdd(out, client, "8080", "http", jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "'http' is not a valid port number"}`, out.String())
+
+	assert.EqualError(t, runPortForwardAdd(out, &fakePortForwardClient{failing: true}, "8080", "80", ""), "daemon not running")
+}
+
+func TestPortForwardList(t *testing.T) {
+	client := &fakePortForwardClient{
+		forwards: []portforward.Forward{
+			{HostPort: 8080, VMPort: 80},
+			{HostPort: 15432, Namespace: "db", Service: "postgres", ServicePort: 5432},
+		},
+	}
+	out := new(bytes.Buffer)
+	assert.NoError(t, runPortForwardList(out, client, ""))
+	assert.Equal(t, `HOST PORT  TARGET
+8080       VM port 80
+15432      service db/postgres:5432
+`, out.String())
+
+	out.Reset()
+	assert.NoError(t, runPortForwardList(out, client, jsonFormat))
+	assert.JSONEq(t, `{"success": true, "forwards": [{"hostPort": 8080, "vmPort": 80}, {"hostPort": 15432, "namespace": "db", "service": "postgres", "servicePort": 5432}]}`, out.String())
+
+	out.Reset()
+	assert.NoError(t, runPortForwardList(out, &fakePortForwardClient{}, ""))
+	assert.Equal(t, "No forwarded ports\n", out.String())
+}
+
+func TestPortForwardRemove(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runPortForwardRemove(out, &fakePortForwardClient{}, "8080", ""))
+	assert.Equal(t, "Stopped forwarding localhost:8080\n", out.String())
+
+	out.Reset()
+	assert.NoError(t, runPortForwardRemove(out, &fakePortForwardClient{}, "http", jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "'http' is not a valid port number"}`, out.String())
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/code-ready/crc/pkg/crc/daemonclient"
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/code-ready/crc/pkg/crc/network"
+	crcos "github.com/code-ready/crc/pkg/os"
+	"github.com/spf13/cobra"
+	"gopkg.in/AlecAivazis/survey.v1"
+)
+
+var (
+	proxyUsername      string
+	proxyPasswordStdin bool
+)
+
+func init() {
+	proxyLoginCmd.Flags().StringVarP(&proxyUsername, "username", "u", "", "Username of the proxy")
+	proxyLoginCmd.Flags().BoolVar(&proxyPasswordStdin, "password-stdin", false, "Read the password of the proxy from stdin")
+	for _, cmd := range []*cobra.Command{proxyLoginCmd, proxyLogoutCmd} {
+		addOutputFormatFlag(cmd)
+		proxyCmd.AddCommand(cmd)
+	}
+	rootCmd.AddCommand(proxyCmd)
+}
+
+var proxyCmd = &cobra.Command{
+	Use:   "proxy SUBCOMMAND [flags]",
+	Short: "Manage the credentials of the proxy",
+	Long: "Manage the credentials of the proxy, they are stored in the keyring of the system instead of the configuration " +
+		"and are added to the proxy URLs which do not contain credentials.",
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+var proxyLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Store the credentials of the proxy",
+	Long:  "Store the username and password of the proxy in the keyring of the system, they are asked when they are not given",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		username, password, err := readProxyCredentials(proxyUsername, proxyPasswordStdin)
+		if err != nil {
+			return err
+		}
+		return runProxyLogin(os.Stdout, newMachine(), isDaemonRunning(), username, password, outputFormat)
+	},
+}
+
+var proxyLogoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Remove the credentials of the proxy",
+	Long:  "Remove the username and password of the proxy from the keyring of the system",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runProxyLogout(os.Stdout, newMachine(), isDaemonRunning(), outputFormat)
+	},
+}
+
+type proxyResult struct {
+	Success bool                         `json:"success"`
+	Error   *crcErrors.SerializableError `json:"error,omitempty"`
+	Message string                       `json:"-"`
+	running bool
+	// the daemon reads the credentials when it starts
+	daemonRunning bool
+}
+
+func (s *proxyResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	if s.running {
+		if _, err := fmt.Fprintf(writer, "%s, run 'crc stop' and 'crc start' to apply the change to the cluster\n", s.Message); err != nil {
+			return err
+		}
+	} else if _, err := fmt.Fprintln(writer, s.Message); err != nil {
+		return err
+	}
+	if s.daemonRunning {
+		_, err := fmt.Fprintln(writer, "The crc daemon only reads the proxy credentials when it starts, restart it to apply the change")
+		return err
+	}
+	return nil
+}
+
+func isDaemonRunning() bool {
+	_, err := daemonclient.New().APIClient.Version()
+	return err == nil
+}
+
+func readProxyCredentials(username string, passwordStdin bool) (string, string, error) {
+	if username == "" {
+		if !crcos.RunningInTerminal() {
+			return "", "", errors.New("cannot ask for the proxy username, crc not launched by a terminal, use --username")
+		}
+		if err := survey.AskOne(&survey.Input{Message: "Proxy username"}, &username, survey.Required); err != nil {
+			return "", "", err
+		}
+	}
+	var password string
+	if passwordStdin {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && err != io.EOF {
+			return "", "", err
+		}
+		password = strings.TrimRight(line, "\r\n")
+	} else {
+		if !crcos.RunningInTerminal() {
+			return "", "", errors.New("cannot ask for the proxy password, crc not launched by a terminal, use --password-stdin")
+		}
+		if err := survey.AskOne(&survey.Password{Message: "Proxy password"}, &password, nil); err != nil {
+			return "", "", err
+		}
+	}
+	return username, password, nil
+}
+
+func runProxyLogin(writer io.Writer, client machine.Client, daemonRunning bool, username, password, outputFormat string) error {
+	err := network.StoreProxyCredentialsInKeyring(username, password)
+	running, _ := client.IsRunning()
+	return render(&proxyResult{
+		Success:       err == nil,
+		Error:         crcErrors.ToSerializableError(err),
+		Message:       fmt.Sprintf("The credentials of %s are stored in the keyring", username),
+		running:       running,
+		daemonRunning: daemonRunning,
+	}, writer, outputFormat)
+}
+
+func runProxyLogout(writer io.Writer, client machine.Client, daemonRunning bool, outputFormat string) error {
+	deleted, err := network.DeleteProxyCredentialsFromKeyring()
+	if err == nil && !deleted {
+		err = errors.New("no proxy credentials in the keyring")
+	}
+	running, _ := client.IsRunning()
+	return render(&proxyResult{
+		Success:       err == nil,
+		Error:         crcErrors.ToSerializableError(err),
+		Message:       "The proxy credentials are removed from the keyring",
+		running:       running,
+		daemonRunning: daemonRunning,
+	}, writer, outputFormat)
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
+	"github.com/code-ready/crc/pkg/crc/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func TestProxyLoginAndLogout(t *testing.T) {
+	keyring.MockInit()
+
+	out := new(bytes.Buffer)
+	assert.NoError(t, runProxyLogin(out, fakemachine.NewClient(), true, "alice", "p@ss:word", ""))
+	assert.Equal(t, "The credentials of alice are stored in the keyring, run 'crc stop' and 'crc start' to apply the change to the cluster\n"+
+		"The crc daemon only reads the proxy credentials when it starts, restart it to apply the change\n", out.String())
+
+	credentials, err := network.LoadProxyCredentialsFromKeyring()
+	require.NoError(t, err)
+	password, _ := credentials.Password()
+	assert.Equal(t, "alice", credentials.Username())
+	assert.Equal(t, "p@ss:word", password)
+
+	out.Reset()
+	assert.NoError(t, runProxyLogout(out, fakemachine.NewClient(), false, jsonFormat))
+	assert.JSONEq(t, `{"success": true}`, out.String())
+
+	out.Reset()
+	assert.NoError(t, runProxyLogout(out, fakemachine.NewClient(), false, jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "no proxy credentials in the keyring"}`, out.String())
+}
+
+func TestProxyLoginWithoutUsername(t *testing.T) {
+	keyring.MockInit()
+
+	out := new(bytes.Buffer)
+	assert.NoError(t, runProxyLogin(out, fakemachine.NewClient(), false, "", "password", jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "The proxy username cannot be empty"}`, out.String())
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	crcConfig "github.com/code-ready/crc/pkg/crc/config"
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/code-ready/crc/pkg/crc/validation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	resizeMemory int
+	resizeCPUs   int
+)
+
+func init() {
+	resizeCmd.Flags().IntVarP(&resizeMemory, crcConfig.Memory, "m", 0, "MiB of memory to allocate to the running VM")
+	resizeCmd.Flags().IntVarP(&resizeCPUs, crcConfig.CPUs, "c", 0, "Number of CPU cores to allocate to the running VM")
+	addOutputFormatFlag(resizeCmd)
+	rootCmd.AddCommand(resizeCmd)
+}
+
+var resizeCmd = &cobra.Command{
+	Use:   "resize",
+	Short: "Change the memory and CPU cores of the running VM",
+	Long: `Change the memory and CPU cores of the running CodeReady Containers VM without restarting it.
+The VM can grow up to the 'max-memory' and 'max-cpus' settings which were set when the cluster was started.
+The changes are lost when the cluster is stopped, use 'crc config set' to keep them.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := validateResizeFlags(resizeMemory, resizeCPUs); err != nil {
+			return err
+		}
+		return runResize(os.Stdout, newMachine(), resizeMemory, resizeCPUs, outputFormat)
+	},
+}
+
+func runResize(writer io.Writer, client machine.Client, memory, cpus int, outputFormat string) error {
+	err := checkIfMachineMissing(client)
+	if err == nil {
+		err = client.Resize(memory, cpus)
+	}
+	return render(&resizeResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+		Memory:  memory,
+		CPUs:    cpus,
+	}, writer, outputFormat)
+}
+
+func validateResizeFlags(memory, cpus int) error {
+	if memory == 0 && cpus == 0 {
+		return errors.New("Use --memory and/or --cpus to set the new size of the VM")
+	}
+	if memory != 0 {
+		if err := validation.ValidateMemory(memory); err != nil {
+			return err
+		}
+	}
+	if cpus != 0 {
+		if err := validation.ValidateCPUs(cpus); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type resizeResult struct {
+	Success bool                         `json:"success"`
+	Error   *crcErrors.SerializableError `json:"error,omitempty"`
+	Memory  int                          `json:"memory,omitempty"`
+	CPUs    int                          `json:"cpus,omitempty"`
+}
+
+func (s *resizeResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	var changes []string
+	if s.CPUs != 0 {
+		changes = append(changes, fmt.Sprintf("%d CPU cores", s.CPUs))
+	}
+	if s.Memory != 0 {
+		changes = append(changes, fmt.Sprintf("%d MiB of memory", s.Memory))
+	}
+	_, err := fmt.Fprintf(writer, "The CodeReady Containers VM now uses %s until it is stopped\n", strings.Join(changes, " and "))
+	return err
+}
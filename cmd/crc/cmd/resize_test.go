@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResizePlainSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runResize(out, fakemachine.NewClient(), 16384, 6, ""))
+	assert.Equal(t, "The CodeReady Containers VM now uses 6 CPU cores and 16384 MiB of memory until it is stopped\n", out.String())
+}
+
+func TestResizePlainError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.EqualError(t, runResize(out, fakemachine.NewFailingClient(), 16384, 0, ""), "resize failed")
+}
+
+func TestValidateResizeFlags(t *testing.T) {
+	assert.EqualError(t, validateResizeFlags(0, 0), "Use --memory and/or --cpus to set the new size of the VM")
+	assert.EqualError(t, validateResizeFlags(0, 1), "requires CPUs >= 4")
+	assert.EqualError(t, validateResizeFlags(1024, 0), "requires memory in MiB >= 9216")
+	assert.NoError(t, validateResizeFlags(0, 6))
+}
+
+func TestResizeJSONSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runResize(out, fakemachine.NewClient(), 0, 6, jsonFormat))
+	assert.JSONEq(t, `{"success": true, "cpus": 6}`, out.String())
+}
+
+func TestResizeJSONError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runResize(out, fakemachine.NewFailingClient(), 0, 6, jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "resize failed", "cpus": 6}`, out.String())
+}
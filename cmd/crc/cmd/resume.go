@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	addOutputFormatFlag(resumeCmd)
+	rootCmd.AddCommand(resumeCmd)
+}
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume the paused OpenShift cluster",
+	Long:  "Resume the paused CodeReady Containers VM and synchronize its clock with the host",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runResume(os.Stdout, newMachine(), outputFormat)
+	},
+}
+
+func runResume(writer io.Writer, client machine.Client, outputFormat string) error {
+	err := checkIfMachineMissing(client)
+	if err == nil {
+		err = client.Resume()
+	}
+	return render(&resumeResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+	}, writer, outputFormat)
+}
+
+type resumeResult struct {
+	Success bool                         `json:"success"`
+	Error   *crcErrors.SerializableError `json:"error,omitempty"`
+}
+
+func (s *resumeResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	_, err := fmt.Fprintln(writer, "Resumed the OpenShift cluster")
+	return err
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResumePlainSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runResume(out, fakemachine.NewClient(), ""))
+	assert.Equal(t, "Resumed the OpenShift cluster\n", out.String())
+}
+
+func TestResumePlainError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.EqualError(t, runResume(out, fakemachine.NewFailingClient(), ""), "resume failed")
+}
+
+func TestResumeJSONSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runResume(out, fakemachine.NewClient(), jsonFormat))
+	assert.JSONEq(t, `{"success": true}`, out.String())
+}
+
+func TestResumeJSONError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runResume(out, fakemachine.NewFailingClient(), jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "resume failed"}`, out.String())
+}
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -44,7 +45,8 @@ var rootCmd = &cobra.Command{
 
 var (
 	globalForce   bool
-	viper         *crcConfig.ViperStorage
+	instanceName  string
+	viper         *crcConfig.InstanceStorage
 	config        *crcConfig.Config
 	segmentClient *segment.Client
 )
@@ -68,6 +70,7 @@ func init() {
 	rootCmd.AddCommand(cmdBundle.GetBundleCmd(config))
 
 	rootCmd.PersistentFlags().StringVar(&logging.LogLevel, "log-level", constants.DefaultLogLevel, "log level (e.g. \"debug | info | warn | error\")")
+	rootCmd.PersistentFlags().StringVar(&instanceName, "instance", constants.DefaultName, "Name of the CodeReady Containers instance to act on, only one instance can run at a time")
 }
 
 func runPrerun(cmd *cobra.Command) error {
@@ -77,6 +80,9 @@ func runPrerun(cmd *cobra.Command) error {
 		logFile = constants.DaemonLogFilePath
 	}
 	logging.InitLogrus(logging.LogLevel, logFile)
+	if err := setInstance(instanceName); err != nil {
+		return err
+	}
 	if err := setProxyDefaults(); err != nil {
 		return err
 	}
@@ -101,16 +107,23 @@ const (
 	preflightFailedExitCode = 2
 )
 
+// errSilent is used when the error was already reported, for instance by a
+// command run in the VM
+var errSilent = errors.New("")
+
 func Execute() {
 	attachMiddleware([]string{}, rootCmd)
 
 	if err := rootCmd.ExecuteContext(telemetry.NewContext(context.Background())); err != nil {
 		runPostrun()
-		_, _ = fmt.Fprintln(os.Stderr, err.Error())
 		var e exec.CodeExitError
 		if errors.As(err, &e) {
+			if e.Err != errSilent {
+				_, _ = fmt.Fprintln(os.Stderr, err.Error())
+			}
 			os.Exit(e.ExitStatus())
 		} else {
+			_, _ = fmt.Fprintln(os.Stderr, err.Error())
 			os.Exit(defaultErrorExitCode)
 		}
 	}
@@ -128,6 +141,19 @@ func checkIfMachineMissing(client machine.Client) error {
 	return nil
 }
 
+var validInstanceName = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// setInstance makes the configuration of the named instance take precedence over the global one
+func setInstance(name string) error {
+	if !validInstanceName.MatchString(name) {
+		return fmt.Errorf("Invalid instance name '%s', it must only contain lowercase alphanumeric characters or '-', and start and end with an alphanumeric character", name)
+	}
+	if name == constants.DefaultName {
+		return viper.SetInstance("")
+	}
+	return viper.SetInstance(constants.GetInstanceConfigPath(name))
+}
+
 func setProxyDefaults() error {
 	httpProxy := config.Get(crcConfig.HTTPProxy).AsString()
 	httpsProxy := config.Get(crcConfig.HTTPSProxy).AsString()
@@ -168,11 +194,12 @@ func trimTrailingEOL(s string) string {
 	return strings.TrimRight(s, "\n")
 }
 
-func newViperConfig() (*crcConfig.Config, *crcConfig.ViperStorage, error) {
-	viper, err := crcConfig.NewViperStorage(constants.ConfigPath, constants.CrcEnvPrefix)
+func newViperConfig() (*crcConfig.Config, *crcConfig.InstanceStorage, error) {
+	global, err := crcConfig.NewViperStorage(constants.ConfigPath, constants.CrcEnvPrefix)
 	if err != nil {
 		return nil, nil, err
 	}
+	viper := crcConfig.NewInstanceStorage(global)
 	cfg := crcConfig.New(viper)
 	crcConfig.RegisterSettings(cfg)
 	preflight.RegisterSettings(cfg)
@@ -180,7 +207,7 @@ func newViperConfig() (*crcConfig.Config, *crcConfig.ViperStorage, error) {
 }
 
 func newMachine() machine.Client {
-	return machine.NewSynchronizedMachine(machine.NewClient(constants.DefaultName, isDebugLog(), config))
+	return machine.NewSynchronizedMachine(machine.NewClient(instanceName, isDebugLog(), config))
 }
 
 func addForceFlag(cmd *cobra.Command) {
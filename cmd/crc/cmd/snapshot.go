@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{snapshotSaveCmd, snapshotListCmd, snapshotRestoreCmd, snapshotDeleteCmd} {
+		addOutputFormatFlag(cmd)
+		snapshotCmd.AddCommand(cmd)
+	}
+	rootCmd.AddCommand(snapshotCmd)
+}
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot SUBCOMMAND [flags]",
+	Short: "Manage snapshots of the CodeReady Containers instance",
+	Long:  "Save, list, restore and delete snapshots of the stopped CodeReady Containers instance",
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+var snapshotSaveCmd = &cobra.Command{
+	Use:   "save NAME",
+	Short: "Save a snapshot of the instance",
+	Long:  "Save a snapshot of the stopped instance disk, kubeconfig and kubeadmin password",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSnapshotSave(os.Stdout, newMachine(), args[0], outputFormat)
+	},
+}
+
+var snapshotListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the snapshots of the instance",
+	Long:  "List the snapshots of the instance",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSnapshotList(os.Stdout, newMachine(), outputFormat)
+	},
+}
+
+var snapshotRestoreCmd = &cobra.Command{
+	Use:   "restore NAME",
+	Short: "Restore a snapshot of the instance",
+	Long:  "Restore the stopped instance to the state it had when the snapshot was saved",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSnapshotRestore(os.Stdout, newMachine(), args[0], outputFormat)
+	},
+}
+
+var snapshotDeleteCmd = &cobra.Command{
+	Use:   "delete NAME",
+	Short: "Delete a snapshot of the instance",
+	Long:  "Delete a snapshot of the instance",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSnapshotDelete(os.Stdout, newMachine(), args[0], outputFormat)
+	},
+}
+
+type snapshotResult struct {
+	Success bool                         `json:"success"`
+	Error   *crcErrors.SerializableError `json:"error,omitempty"`
+	Message string                       `json:"-"`
+}
+
+func (s *snapshotResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	_, err := fmt.Fprintln(writer, s.Message)
+	return err
+}
+
+func runSnapshotSave(writer io.Writer, client machine.Client, name, outputFormat string) error {
+	err := checkIfMachineMissing(client)
+	if err == nil {
+		err = client.SaveSnapshot(name)
+	}
+	return render(&snapshotResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+		Message: fmt.Sprintf("Snapshot %s saved", name),
+	}, writer, outputFormat)
+}
+
+func runSnapshotRestore(writer io.Writer, client machine.Client, name, outputFormat string) error {
+	err := checkIfMachineMissing(client)
+	if err == nil {
+		err = client.RestoreSnapshot(name)
+	}
+	return render(&snapshotResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+		Message: fmt.Sprintf("Snapshot %s restored, use 'crc start' to start the instance", name),
+	}, writer, outputFormat)
+}
+
+func runSnapshotDelete(writer io.Writer, client machine.Client, name, outputFormat string) error {
+	err := checkIfMachineMissing(client)
+	if err == nil {
+		err = client.DeleteSnapshot(name)
+	}
+	return render(&snapshotResult{
+		Success: err == nil,
+		Error:   crcErrors.ToSerializableError(err),
+		Message: fmt.Sprintf("Snapshot %s deleted", name),
+	}, writer, outputFormat)
+}
+
+type snapshot struct {
+	Name         string `json:"name"`
+	CreationTime string `json:"creationTime,omitempty"`
+}
+
+type snapshotListResult struct {
+	Success   bool                         `json:"success"`
+	Error     *crcErrors.SerializableError `json:"error,omitempty"`
+	Snapshots []snapshot                   `json:"snapshots"`
+}
+
+func runSnapshotList(writer io.Writer, client machine.Client, outputFormat string) error {
+	if err := checkIfMachineMissing(client); err != nil {
+		return render(&snapshotListResult{Success: false, Error: crcErrors.ToSerializableError(err), Snapshots: []snapshot{}}, writer, outputFormat)
+	}
+	details, err := client.ListSnapshots()
+	if err != nil {
+		return render(&snapshotListResult{Success: false, Error: crcErrors.ToSerializableError(err), Snapshots: []snapshot{}}, writer, outputFormat)
+	}
+	snapshots := []snapshot{}
+	for _, detail := range details {
+		s := snapshot{Name: detail.Name}
+		if !detail.CreationTime.IsZero() {
+			s.CreationTime = detail.CreationTime.Format(time.RFC3339)
+		}
+		snapshots = append(snapshots, s)
+	}
+	return render(&snapshotListResult{Success: true, Snapshots: snapshots}, writer, outputFormat)
+}
+
+func (s *snapshotListResult) prettyPrintTo(writer io.Writer) error {
+	if s.Error != nil {
+		return s.Error
+	}
+	if len(s.Snapshots) == 0 {
+		_, err := fmt.Fprintln(writer, "No snapshots found")
+		return err
+	}
+	w := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(w, "NAME\tCREATED"); err != nil {
+		return err
+	}
+	for _, snapshot := range s.Snapshots {
+		if _, err := fmt.Fprintf(w, "%s\t%s\n", snapshot.Name, snapshot.CreationTime); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotSavePlainSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runSnapshotSave(out, fakemachine.NewClient(), "fresh", ""))
+	assert.Equal(t, "Snapshot fresh saved\n", out.String())
+}
+
+func TestSnapshotSaveJSONError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runSnapshotSave(out, fakemachine.NewFailingClient(), "fresh", jsonFormat))
+	assert.JSONEq(t, `{"success": false, "error": "snapshot save failed"}`, out.String())
+}
+
+func TestSnapshotRestorePlainError(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.EqualError(t, runSnapshotRestore(out, fakemachine.NewFailingClient(), "fresh", ""), "snapshot restore failed")
+}
+
+func TestSnapshotDeleteJSONSuccess(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runSnapshotDelete(out, fakemachine.NewClient(), "fresh", jsonFormat))
+	assert.JSONEq(t, `{"success": true}`, out.String())
+}
+
+func TestSnapshotListPlain(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runSnapshotList(out, fakemachine.NewClient(), ""))
+	assert.Equal(t, "NAME   CREATED\nfresh  2021-06-01T10:00:00Z\n", out.String())
+}
+
+func TestSnapshotListJSON(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NoError(t, runSnapshotList(out, fakemachine.NewClient(), jsonFormat))
+	assert.JSONEq(t, `{"success": true, "snapshots": [{"name": "fresh", "creationTime": "2021-06-01T10:00:00Z"}]}`, out.String())
+}
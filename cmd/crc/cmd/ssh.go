@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+
+	"github.com/code-ready/crc/pkg/crc/machine"
+	crcssh "github.com/code-ready/crc/pkg/crc/ssh"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
+	terminal "golang.org/x/term"
+	"k8s.io/client-go/util/exec"
+)
+
+func init() {
+	rootCmd.AddCommand(sshCmd)
+}
+
+var sshCmd = &cobra.Command{
+	Use:   "ssh",
+	Short: "Open a shell in the OpenShift cluster VM",
+	Long:  "Open an interactive shell in the CodeReady Containers VM",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSSH(newMachine())
+	},
+}
+
+func runSSH(client machine.Client) error {
+	stdin := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(stdin) {
+		return errors.New("'crc ssh' requires a terminal, use 'crc exec' to run commands in the VM")
+	}
+	sshRunner, err := createVMRunner(client)
+	if err != nil {
+		return err
+	}
+	defer sshRunner.Close()
+
+	width, height, err := terminal.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		width, height = 80, 24
+	}
+	term := os.Getenv("TERM")
+	if term == "" {
+		term = "xterm-256color"
+	}
+	state, err := terminal.MakeRaw(stdin)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = terminal.Restore(stdin, state)
+	}()
+	return remoteExitStatus(sshRunner.Shell(term, width, height, os.Stdin, os.Stdout, os.Stderr))
+}
+
+// createVMRunner connects to the running VM with the details of the instance
+func createVMRunner(client machine.Client) (*crcssh.Runner, error) {
+	if err := checkIfMachineMissing(client); err != nil {
+		return nil, err
+	}
+	running, err := client.IsRunning()
+	if err != nil {
+		return nil, err
+	}
+	if !running {
+		return nil, errors.New("The CodeReady Containers VM is not running")
+	}
+	details, err := client.ConnectionDetails()
+	if err != nil {
+		return nil, err
+	}
+	return crcssh.CreateRunner(details.IP, details.SSHPort, details.SSHKeys...)
+}
+
+// remoteExitStatus makes crc exit with the status of the command run in the
+// VM, without printing an error message as its stderr was already forwarded
+func remoteExitStatus(err error) error {
+	var exitErr *ssh.ExitError
+	if errors.As(err, &exitErr) {
+		return exec.CodeExitError{
+			Err:  errSilent,
+			Code: exitErr.ExitStatus(),
+		}
+	}
+	return err
+}
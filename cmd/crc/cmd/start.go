@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"runtime"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/code-ready/crc/pkg/crc/cluster"
@@ -38,7 +40,7 @@ func init() {
 	flagSet.IntP(crcConfig.CPUs, "c", constants.DefaultCPUs, "Number of CPU cores to allocate to the OpenShift cluster")
 	flagSet.IntP(crcConfig.Memory, "m", constants.DefaultMemory, "MiB of memory to allocate to the OpenShift cluster")
 	flagSet.UintP(crcConfig.DiskSize, "d", constants.DefaultDiskSize, "Total size in GiB of the disk used by the OpenShift cluster")
-	flagSet.StringP(crcConfig.NameServer, "n", "", "IPv4 address of nameserver to use for the OpenShift cluster")
+	flagSet.StringP(crcConfig.NameServer, "n", "", "IPv4 or IPv6 addresses of nameservers to use for the OpenShift cluster (comma separated)")
 	flagSet.Bool(crcConfig.DisableUpdateCheck, false, "Don't check for update")
 
 	startCmd.Flags().AddFlagSet(flagSet)
@@ -47,16 +49,23 @@ func init() {
 var startCmd = &cobra.Command{
 	Use:   "start",
 	Short: "Start the OpenShift cluster",
-	Long:  "Start the OpenShift cluster",
+	Long: `Start the OpenShift cluster
+
+With '-o json', the progress of each start phase is streamed as one JSON
+object per line, followed by a line with the result of the command.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := viper.BindFlagSet(cmd.Flags()); err != nil {
 			return err
 		}
-		return renderStartResult(runStart(cmd.Context()))
+		var progress func(types.StartEvent)
+		if outputFormat == jsonFormat {
+			progress = streamStartEvents(os.Stdout)
+		}
+		return renderStartResult(runStart(cmd.Context(), progress))
 	},
 }
 
-func runStart(ctx context.Context) (*types.StartResult, error) {
+func runStart(ctx context.Context, progress func(types.StartEvent)) (*types.StartResult, error) {
 	if err := validateStartFlags(); err != nil {
 		return nil, err
 	}
@@ -70,9 +79,15 @@ func runStart(ctx context.Context) (*types.StartResult, error) {
 		Memory:            config.Get(crcConfig.Memory).AsInt(),
 		DiskSize:          config.Get(crcConfig.DiskSize).AsInt(),
 		CPUs:              config.Get(crcConfig.CPUs).AsInt(),
-		NameServer:        config.Get(crcConfig.NameServer).AsString(),
+		MaxMemory:         config.Get(crcConfig.MaxMemory).AsInt(),
+		MaxCPUs:           config.Get(crcConfig.MaxCPUs).AsInt(),
+		DataDiskSize:      config.Get(crcConfig.DataDiskSize).AsInt(),
+		SharedDirs:        crcConfig.GetSharedDirs(config),
+		NameServers:       crcConfig.GetNameServers(config),
+		SearchDomains:     crcConfig.GetSearchDomains(config),
 		PullSecret:        cluster.NewInteractivePullSecretLoader(config),
 		KubeAdminPassword: config.Get(crcConfig.KubeAdminPassword).AsString(),
+		Progress:          progress,
 	}
 
 	client := newMachine()
@@ -95,11 +110,46 @@ func runStart(ctx context.Context) (*types.StartResult, error) {
 }
 
 func renderStartResult(result *types.StartResult, err error) error {
-	return render(&startResult{
+	startResult := &startResult{
 		Success:       err == nil,
 		Error:         crcErrors.ToSerializableError(err),
 		ClusterConfig: toClusterConfig(result),
-	}, os.Stdout, outputFormat)
+	}
+	if outputFormat == jsonFormat {
+		// The result is the last line of the stream of start events
+		return json.NewEncoder(os.Stdout).Encode(startResult)
+	}
+	return render(startResult, os.Stdout, outputFormat)
+}
+
+type startEvent struct {
+	Phase       types.StartPhase   `json:"phase"`
+	Description string             `json:"description"`
+	StartTime   time.Time          `json:"startTime"`
+	EndTime     *time.Time         `json:"endTime,omitempty"`
+	Outcome     types.PhaseOutcome `json:"outcome"`
+	Error       string             `json:"error,omitempty"`
+}
+
+// streamStartEvents returns a start progress callback writing each event as
+// a single line of JSON to writer
+func streamStartEvents(writer io.Writer) func(types.StartEvent) {
+	encoder := json.NewEncoder(writer)
+	return func(event types.StartEvent) {
+		jsonEvent := startEvent{
+			Phase:       event.Phase,
+			Description: event.Description,
+			StartTime:   event.StartTime,
+			Outcome:     event.Outcome,
+			Error:       event.Error,
+		}
+		if !event.EndTime.IsZero() {
+			jsonEvent.EndTime = &event.EndTime
+		}
+		if err := encoder.Encode(jsonEvent); err != nil {
+			logging.Debugf("Cannot write start event: %v", err)
+		}
+	}
 }
 
 func toClusterConfig(result *types.StartResult) *clusterConfig {
@@ -183,10 +233,8 @@ func validateStartFlags() error {
 	if err := validation.ValidateBundle(config.Get(crcConfig.Bundle).AsString()); err != nil {
 		return err
 	}
-	if config.Get(crcConfig.NameServer).AsString() != "" {
-		if err := validation.ValidateIPAddress(config.Get(crcConfig.NameServer).AsString()); err != nil {
-			return err
-		}
+	if _, err := network.ParseNameServers(config.Get(crcConfig.NameServer).AsString()); err != nil {
+		return err
 	}
 	return nil
 }
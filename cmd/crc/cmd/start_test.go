@@ -5,8 +5,10 @@ import (
 	"errors"
 	"runtime"
 	"testing"
+	"time"
 
 	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/machine/types"
 	"github.com/code-ready/crc/pkg/os/shell"
 	"github.com/stretchr/testify/assert"
 )
@@ -102,6 +104,29 @@ func TestRenderActionJSONFailure(t *testing.T) {
 	assert.JSONEq(t, `{"success": false, "error": "broken"}`, out.String())
 }
 
+func TestStreamStartEvents(t *testing.T) {
+	out := new(bytes.Buffer)
+	progress := streamStartEvents(out)
+	startTime := time.Date(2021, time.June, 1, 10, 0, 0, 0, time.UTC)
+	progress(types.StartEvent{
+		Phase:       types.PhaseStartVM,
+		Description: "Starting the CodeReady Containers VM",
+		StartTime:   startTime,
+		Outcome:     types.PhaseInProgress,
+	})
+	progress(types.StartEvent{
+		Phase:       types.PhaseStartVM,
+		Description: "Starting the CodeReady Containers VM",
+		StartTime:   startTime,
+		EndTime:     startTime.Add(time.Minute),
+		Outcome:     types.PhaseFailed,
+		Error:       "broken",
+	})
+	assert.Equal(t, `{"phase":"start-vm","description":"Starting the CodeReady Containers VM","startTime":"2021-06-01T10:00:00Z","outcome":"in-progress"}
+{"phase":"start-vm","description":"Starting the CodeReady Containers VM","startTime":"2021-06-01T10:00:00Z","endTime":"2021-06-01T10:01:00Z","outcome":"failed","error":"broken"}
+`, out.String())
+}
+
 const unixTemplate = `Started the OpenShift cluster.
 
 The server is accessible via web console at:
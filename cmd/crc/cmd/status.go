@@ -1,21 +1,42 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/code-ready/crc/pkg/crc/cluster"
 	"github.com/code-ready/crc/pkg/crc/constants"
+	"github.com/code-ready/crc/pkg/crc/daemonclient"
 	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/logging"
 	"github.com/code-ready/crc/pkg/crc/machine"
 	"github.com/code-ready/crc/pkg/crc/machine/types"
 	"github.com/docker/go-units"
 	"github.com/spf13/cobra"
+	terminal "golang.org/x/term"
+)
+
+const (
+	statusWatchInterval = 5 * time.Second
+	// moves the cursor to the top left corner and clears the terminal
+	clearScreen = "\x1b[H\x1b[2J"
+)
+
+var (
+	statusOperators bool
+	statusWatch     bool
 )
 
 func init() {
+	statusCmd.Flags().BoolVar(&statusOperators, "operators", false, "Show the status of each cluster operator")
+	statusCmd.Flags().BoolVarP(&statusWatch, "watch", "w", false, "Print the status again each time it changes")
 	addOutputFormatFlag(statusCmd)
 	rootCmd.AddCommand(statusCmd)
 }
@@ -25,10 +46,25 @@ var statusCmd = &cobra.Command{
 	Short: "Display status of the OpenShift cluster",
 	Long:  "Show details about the OpenShift cluster",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return runStatus(os.Stdout, newMachine(), constants.MachineCacheDir, outputFormat)
+		if statusWatch {
+			return runWatchStatus(context.Background(), os.Stdout, newMachine(), getAutoStopWarning, constants.MachineCacheDir,
+				statusOperators, outputFormat, terminal.IsTerminal(int(os.Stdout.Fd())))
+		}
+		return runStatus(os.Stdout, newMachine(), getAutoStopWarning, constants.MachineCacheDir, statusOperators, outputFormat)
 	},
 }
 
+// getAutoStopWarning returns the warning of the daemon about an imminent stop
+// of the idle cluster, if any
+func getAutoStopWarning() string {
+	autoStop, err := daemonclient.New().APIClient.AutoStopStatus()
+	if err != nil {
+		logging.Debugf("Cannot get the auto-stop status from the daemon: %v", err)
+		return ""
+	}
+	return autoStop.Warning
+}
+
 type status struct {
 	Success          bool                         `json:"success"`
 	Error            *crcErrors.SerializableError `json:"error,omitempty"`
@@ -37,21 +73,38 @@ type status struct {
 	OpenShiftVersion string                       `json:"openshiftVersion,omitempty"`
 	DiskUsage        int64                        `json:"diskUsage,omitempty"`
 	DiskSize         int64                        `json:"diskSize,omitempty"`
+	RAMUsage         int64                        `json:"ramUsage,omitempty"`
+	RAMSize          int64                        `json:"ramSize,omitempty"`
+	LoadAverage      []float64                    `json:"loadAverage,omitempty"`
+	Pods             int                          `json:"pods,omitempty"`
+	Containers       int                          `json:"containers,omitempty"`
+	NameServers      []string                     `json:"nameServers,omitempty"`
+	SearchDomains    []string                     `json:"searchDomains,omitempty"`
 	CacheUsage       int64                        `json:"cacheUsage,omitempty"`
 	CacheDir         string                       `json:"cacheDir,omitempty"`
+	AutoStopWarning  string                       `json:"autoStopWarning,omitempty"`
+	Operators        []cluster.OperatorStatus     `json:"operators,omitempty"`
+
+	// showOperators prints the operators table after the status
+	showOperators bool
 }
 
-func runStatus(writer io.Writer, client machine.Client, cacheDir, outputFormat string) error {
-	status := getStatus(client, cacheDir)
+func runStatus(writer io.Writer, client machine.Client, autoStopWarning func() string, cacheDir string, showOperators bool, outputFormat string) error {
+	status := getStatus(client, autoStopWarning, cacheDir)
+	status.showOperators = showOperators
 	return render(status, writer, outputFormat)
 }
 
-func getStatus(client machine.Client, cacheDir string) *status {
+func getStatus(client machine.Client, autoStopWarning func() string, cacheDir string) *status {
 	if err := checkIfMachineMissing(client); err != nil {
 		return &status{Success: false, Error: crcErrors.ToSerializableError(err)}
 	}
 
 	clusterStatus, err := client.Status()
+	return newStatus(clusterStatus, err, autoStopWarning, cacheDir)
+}
+
+func newStatus(clusterStatus *types.ClusterStatusResult, err error, autoStopWarning func() string, cacheDir string) *status {
 	if err != nil {
 		return &status{Success: false, Error: crcErrors.ToSerializableError(err)}
 	}
@@ -73,37 +126,145 @@ func getStatus(client machine.Client, cacheDir string) *status {
 		OpenShiftVersion: clusterStatus.OpenshiftVersion,
 		DiskUsage:        clusterStatus.DiskUse,
 		DiskSize:         clusterStatus.DiskSize,
+		RAMUsage:         clusterStatus.RAMUse,
+		RAMSize:          clusterStatus.RAMSize,
+		LoadAverage:      clusterStatus.LoadAverage,
+		Pods:             clusterStatus.Pods,
+		Containers:       clusterStatus.Containers,
+		NameServers:      clusterStatus.NameServers,
+		SearchDomains:    clusterStatus.SearchDomains,
 		CacheUsage:       size,
 		CacheDir:         cacheDir,
+		AutoStopWarning:  autoStopWarning(),
+		Operators:        clusterStatus.Operators,
 	}
 }
 
+// runWatchStatus renders the status each time it changes, until ctx is
+// cancelled. With inPlace, the previous status is cleared from the terminal.
+func runWatchStatus(ctx context.Context, writer io.Writer, client machine.Client, autoStopWarning func() string, cacheDir string,
+	showOperators bool, outputFormat string, inPlace bool) error {
+	if err := checkIfMachineMissing(client); err != nil {
+		return render(&status{Success: false, Error: crcErrors.ToSerializableError(err)}, writer, outputFormat)
+	}
+
+	updates, unsubscribe := machine.NewStatusPoller(client, statusWatchInterval).Subscribe()
+	defer unsubscribe()
+	separator := ""
+	if inPlace {
+		separator = clearScreen
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update := <-updates:
+			status := newStatus(update.Status, update.Err, autoStopWarning, cacheDir)
+			status.showOperators = showOperators
+			if err := renderWatchedStatus(writer, status, outputFormat, separator); err != nil {
+				return err
+			}
+			if !inPlace {
+				separator = "\n"
+			}
+		}
+	}
+}
+
+func renderWatchedStatus(writer io.Writer, status *status, outputFormat string, separator string) error {
+	if outputFormat != "" {
+		return render(status, writer, outputFormat)
+	}
+	if _, err := fmt.Fprint(writer, separator); err != nil {
+		return err
+	}
+	// a failure is displayed, the next status may be a success
+	if status.Error != nil {
+		_, err := fmt.Fprintf(writer, "Error: %v\n", status.Error)
+		return err
+	}
+	return status.prettyPrintTo(writer)
+}
+
 func (s *status) prettyPrintTo(writer io.Writer) error {
 	if s.Error != nil {
 		return s.Error
 	}
 	w := tabwriter.NewWriter(writer, 0, 0, 1, ' ', 0)
 
-	lines := []struct {
+	type line struct {
 		left, right string
-	}{
+	}
+	lines := []line{
 		{"CRC VM", s.CrcStatus},
 		{"OpenShift", openshiftStatus(s)},
 		{"Disk Usage", fmt.Sprintf(
 			"%s of %s (Inside the CRC VM)",
 			units.HumanSize(float64(s.DiskUsage)),
 			units.HumanSize(float64(s.DiskSize)))},
-		{"Cache Usage", units.HumanSize(float64(s.CacheUsage))},
-		{"Cache Directory", s.CacheDir},
+	}
+	// unknown when the VM is stopped or cannot be reached
+	if s.RAMSize > 0 {
+		lines = append(lines,
+			line{"RAM Usage", fmt.Sprintf(
+				"%s of %s (Inside the CRC VM)",
+				units.HumanSize(float64(s.RAMUsage)),
+				units.HumanSize(float64(s.RAMSize)))},
+			line{"CPU Load", formatLoadAverage(s.LoadAverage)},
+			line{"Workloads", fmt.Sprintf("%d pods, %d containers", s.Pods, s.Containers)})
+	}
+	if len(s.NameServers) > 0 {
+		lines = append(lines, line{"Nameservers", strings.Join(s.NameServers, ", ")})
+	}
+	if len(s.SearchDomains) > 0 {
+		lines = append(lines, line{"Search Domains", strings.Join(s.SearchDomains, ", ")})
+	}
+	lines = append(lines,
+		line{"Cache Usage", units.HumanSize(float64(s.CacheUsage))},
+		line{"Cache Directory", s.CacheDir})
+	if s.AutoStopWarning != "" {
+		lines = append(lines, line{"Auto-stop", s.AutoStopWarning})
 	}
 	for _, line := range lines {
 		if err := printLine(w, line.left, line.right); err != nil {
 			return err
 		}
 	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if s.showOperators && len(s.Operators) > 0 {
+		return printOperators(writer, s.Operators)
+	}
+	return nil
+}
+
+func printOperators(writer io.Writer, operators []cluster.OperatorStatus) error {
+	w := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(w, "\nNAME\tAVAILABLE\tPROGRESSING\tDEGRADED\tSINCE\tMESSAGE"); err != nil {
+		return err
+	}
+	for _, operator := range operators {
+		since := ""
+		if !operator.LastTransitionTime.IsZero() {
+			since = operator.LastTransitionTime.Local().Format("2006-01-02 15:04:05")
+		}
+		if _, err := fmt.Fprintf(w, "%s\t%t\t%t\t%t\t%s\t%s\n", operator.Name, operator.Available,
+			operator.Progressing, operator.Degraded, since, operator.Message); err != nil {
+			return err
+		}
+	}
 	return w.Flush()
 }
 
+func formatLoadAverage(averages []float64) string {
+	formatted := make([]string, len(averages))
+	for i, average := range averages {
+		formatted[i] = strconv.FormatFloat(average, 'f', 2, 64)
+	}
+	return strings.Join(formatted, ", ") + " (1, 5, 15 minutes)"
+}
+
 func openshiftStatus(status *status) string {
 	if status.OpenShiftVersion != "" {
 		return fmt.Sprintf("%s (v%s)", status.OpenShiftStatus, status.OpenShiftVersion)
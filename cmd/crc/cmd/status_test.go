@@ -2,12 +2,15 @@ package cmd
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
 
@@ -23,17 +26,75 @@ func TestPlainStatus(t *testing.T) {
 	require.NoError(t, ioutil.WriteFile(filepath.Join(cacheDir, "crc.qcow2"), make([]byte, 10000), 0600))
 
 	out := new(bytes.Buffer)
-	assert.NoError(t, runStatus(out, fakemachine.NewClient(), cacheDir, ""))
+	assert.NoError(t, runStatus(out, fakemachine.NewClient(), noAutoStopWarning, cacheDir, false, ""))
 
 	expected := `CRC VM:          Running
 OpenShift:       Running (v4.5.1)
 Disk Usage:      10GB of 20GB (Inside the CRC VM)
+RAM Usage:       6GB of 9GB (Inside the CRC VM)
+CPU Load:        1.52, 0.98, 0.61 (1, 5, 15 minutes)
+Workloads:       62 pods, 81 containers
+Nameservers:     10.88.0.8, 192.168.130.1
+Search Domains:  crc.testing
 Cache Usage:     10kB
 Cache Directory: %s
 `
 	assert.Equal(t, fmt.Sprintf(expected, cacheDir), out.String())
 }
 
+func TestPlainStatusWithOperators(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	out := new(bytes.Buffer)
+	assert.NoError(t, runStatus(out, fakemachine.NewClient(), noAutoStopWarning, cacheDir, true, ""))
+
+	expected := `CRC VM:          Running
+OpenShift:       Running (v4.5.1)
+Disk Usage:      10GB of 20GB (Inside the CRC VM)
+RAM Usage:       6GB of 9GB (Inside the CRC VM)
+CPU Load:        1.52, 0.98, 0.61 (1, 5, 15 minutes)
+Workloads:       62 pods, 81 containers
+Nameservers:     10.88.0.8, 192.168.130.1
+Search Domains:  crc.testing
+Cache Usage:     0B
+Cache Directory: %s
+
+NAME            AVAILABLE  PROGRESSING  DEGRADED  SINCE                MESSAGE
+authentication  true       false        false     %s  
+ingress         true       false        false     %s  desired and current number of IngressControllers are equal
+`
+	assert.Equal(t, fmt.Sprintf(expected, cacheDir,
+		time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC).Local().Format("2006-01-02 15:04:05"),
+		time.Date(2021, 6, 1, 10, 30, 0, 0, time.UTC).Local().Format("2006-01-02 15:04:05")), out.String())
+}
+
+func TestPlainStatusWithAutoStopWarning(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	out := new(bytes.Buffer)
+	assert.NoError(t, runStatus(out, fakemachine.NewClient(), func() string {
+		return "The OpenShift cluster has been idle for 56 minutes and will be stopped in 4 minutes"
+	}, cacheDir, false, ""))
+
+	expected := `CRC VM:          Running
+OpenShift:       Running (v4.5.1)
+Disk Usage:      10GB of 20GB (Inside the CRC VM)
+RAM Usage:       6GB of 9GB (Inside the CRC VM)
+CPU Load:        1.52, 0.98, 0.61 (1, 5, 15 minutes)
+Workloads:       62 pods, 81 containers
+Nameservers:     10.88.0.8, 192.168.130.1
+Search Domains:  crc.testing
+Cache Usage:     0B
+Cache Directory: %s
+Auto-stop:       The OpenShift cluster has been idle for 56 minutes and will be stopped in 4 minutes
+`
+	assert.Equal(t, fmt.Sprintf(expected, cacheDir), out.String())
+}
+
 func TestJsonStatus(t *testing.T) {
 	cacheDir, err := ioutil.TempDir("", "cache")
 	require.NoError(t, err)
@@ -42,7 +103,7 @@ func TestJsonStatus(t *testing.T) {
 	require.NoError(t, ioutil.WriteFile(filepath.Join(cacheDir, "crc.qcow2"), make([]byte, 10000), 0600))
 
 	out := new(bytes.Buffer)
-	assert.NoError(t, runStatus(out, fakemachine.NewClient(), cacheDir, jsonFormat))
+	assert.NoError(t, runStatus(out, fakemachine.NewClient(), noAutoStopWarning, cacheDir, false, jsonFormat))
 
 	expected := `{
   "success": true,
@@ -51,8 +112,43 @@ func TestJsonStatus(t *testing.T) {
   "openshiftVersion": "4.5.1",
   "diskUsage": 10000000000,
   "diskSize": 20000000000,
+  "ramUsage": 6000000000,
+  "ramSize": 9000000000,
+  "loadAverage": [
+    1.52,
+    0.98,
+    0.61
+  ],
+  "pods": 62,
+  "containers": 81,
+  "nameServers": [
+    "10.88.0.8",
+    "192.168.130.1"
+  ],
+  "searchDomains": [
+    "crc.testing"
+  ],
   "cacheUsage": 10000,
-  "cacheDir": "%s"
+  "cacheDir": "%s",
+  "operators": [
+    {
+      "name": "authentication",
+      "available": true,
+      "progressing": false,
+      "degraded": false,
+      "disabled": false,
+      "lastTransitionTime": "2021-06-01T10:00:00Z"
+    },
+    {
+      "name": "ingress",
+      "available": true,
+      "progressing": false,
+      "degraded": false,
+      "disabled": false,
+      "message": "desired and current number of IngressControllers are equal",
+      "lastTransitionTime": "2021-06-01T10:30:00Z"
+    }
+  ]
 }
 `
 	assert.Equal(t, fmt.Sprintf(expected, strings.ReplaceAll(cacheDir, `\`, `\\`)), out.String())
@@ -66,7 +162,7 @@ func TestPlainStatusWithError(t *testing.T) {
 	require.NoError(t, ioutil.WriteFile(filepath.Join(cacheDir, "crc.qcow2"), make([]byte, 10000), 0600))
 
 	out := new(bytes.Buffer)
-	assert.EqualError(t, runStatus(out, fakemachine.NewFailingClient(), cacheDir, ""), "broken")
+	assert.EqualError(t, runStatus(out, fakemachine.NewFailingClient(), noAutoStopWarning, cacheDir, false, ""), "broken")
 	assert.Equal(t, "", out.String())
 }
 
@@ -78,7 +174,7 @@ func TestJsonStatusWithError(t *testing.T) {
 	require.NoError(t, ioutil.WriteFile(filepath.Join(cacheDir, "crc.qcow2"), make([]byte, 10000), 0600))
 
 	out := new(bytes.Buffer)
-	assert.NoError(t, runStatus(out, fakemachine.NewFailingClient(), cacheDir, jsonFormat))
+	assert.NoError(t, runStatus(out, fakemachine.NewFailingClient(), noAutoStopWarning, cacheDir, false, jsonFormat))
 
 	expected := `{
   "success": false,
@@ -87,3 +183,39 @@ func TestJsonStatusWithError(t *testing.T) {
 `
 	assert.Equal(t, expected, out.String())
 }
+
+func noAutoStopWarning() string {
+	return ""
+}
+
+func TestWatchStatus(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := &cancellingWriter{cancel: cancel}
+	assert.NoError(t, runWatchStatus(ctx, out, fakemachine.NewClient(), noAutoStopWarning, cacheDir, false, jsonFormat, false))
+
+	var status map[string]interface{}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &status))
+	assert.Equal(t, "Running", status["crcStatus"])
+}
+
+func TestWatchStatusWithError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	out := &cancellingWriter{cancel: cancel}
+	assert.NoError(t, runWatchStatus(ctx, out, fakemachine.NewFailingClient(), noAutoStopWarning, "", false, jsonFormat, false))
+	assert.JSONEq(t, `{"success": false, "error": "broken"}`, out.String())
+}
+
+// cancellingWriter stops the watch after the first status
+type cancellingWriter struct {
+	bytes.Buffer
+	cancel func()
+}
+
+func (w *cancellingWriter) Write(p []byte) (int, error) {
+	defer w.cancel()
+	return w.Buffer.Write(p)
+}
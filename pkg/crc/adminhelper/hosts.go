@@ -2,6 +2,7 @@ package adminhelper
 
 import (
 	"path/filepath"
+	"strings"
 
 	"github.com/code-ready/admin-helper/pkg/hosts"
 	"github.com/code-ready/admin-helper/pkg/types"
@@ -12,6 +13,12 @@ var (
 	BinPath = filepath.Join(constants.BinDir(), constants.GetAdminHelperExecutable())
 )
 
+// IsSupportedHostname returns true for the hostnames the admin helper accepts
+// to write in the hosts file, it only accepts the domains of the bundles
+func IsSupportedHostname(hostname string) bool {
+	return strings.HasSuffix(hostname, constants.ClusterDomain) || strings.HasSuffix(hostname, constants.AppsDomain)
+}
+
 // UpdateHostsFile updates the host's /etc/hosts file with Instance IP.
 func UpdateHostsFile(instanceIP string, hostnames ...string) error {
 	if err := RemoveFromHostsFile(hostnames...); err != nil {
@@ -41,16 +48,87 @@ func AddToHostsFile(instanceIP string, hostnames ...string) error {
 	})
 }
 
+// AddCustomHostsToHostsFile adds hostnames the admin helper rejects, those of
+// the custom domains set in the config, to the hosts file. The file is written
+// with administrator privileges, only when some entries are missing.
+func AddCustomHostsToHostsFile(instanceIP string, hostnames ...string) error {
+	hosts, err := hosts.New()
+	if err != nil {
+		return err
+	}
+	missing := false
+	for _, hostname := range hostnames {
+		if !hosts.File.Has(instanceIP, hostname) {
+			missing = true
+		}
+	}
+	if !missing {
+		return nil
+	}
+	for _, hostname := range hostnames {
+		if err := hosts.File.RemoveByHostname(hostname); err != nil {
+			return err
+		}
+	}
+	if err := hosts.File.Add(instanceIP, hostnames...); err != nil {
+		return err
+	}
+	return writeHostsFile(hosts, "Adding the custom domains to the hosts file")
+}
+
+// removeCustomHostsFromHostsFile removes the entries of the given domains from
+// the hosts file with administrator privileges, when there are some
+func removeCustomHostsFromHostsFile(domains ...string) error {
+	hosts, err := hosts.New()
+	if err != nil {
+		return err
+	}
+	var toDelete []string
+	for _, line := range hosts.File.Lines {
+		for _, hostname := range line.Hosts {
+			for _, domain := range domains {
+				if strings.HasSuffix(hostname, "."+domain) {
+					toDelete = append(toDelete, hostname)
+				}
+			}
+		}
+	}
+	if len(toDelete) == 0 {
+		return nil
+	}
+	for _, hostname := range toDelete {
+		if err := hosts.File.RemoveByHostname(hostname); err != nil {
+			return err
+		}
+	}
+	return writeHostsFile(hosts, "Removing the custom domains from the hosts file")
+}
+
+func writeHostsFile(h *hosts.Hosts, reason string) error {
+	var content strings.Builder
+	for _, line := range h.File.Lines {
+		content.WriteString(line.ToRaw())
+		content.WriteString(hostsFileEOL)
+	}
+	return writeHostsFileAsAdmin(reason, h.File.Path, content.String())
+}
+
 func RemoveFromHostsFile(hostnames ...string) error {
 	return instance().Remove(&types.RemoveRequest{
 		Hosts: hostnames,
 	})
 }
 
-func CleanHostsFile() error {
-	return instance().Clean(&types.CleanRequest{
+// CleanHostsFile removes the entries of the domains of the bundles and of the
+// given domains from the hosts file
+func CleanHostsFile(domains ...string) error {
+	if err := instance().Clean(&types.CleanRequest{
 		Domains: []string{constants.ClusterDomain, constants.AppsDomain},
-	})
+	}); err != nil {
+		return err
+	}
+	// the admin helper rejects the hostnames of the custom domains
+	return removeCustomHostsFromHostsFile(domains...)
 }
 
 type helper interface {
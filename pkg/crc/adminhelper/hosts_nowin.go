@@ -7,6 +7,12 @@ import (
 	crcos "github.com/code-ready/crc/pkg/os"
 )
 
+const hostsFileEOL = "\n"
+
+func writeHostsFileAsAdmin(reason, path, content string) error {
+	return crcos.WriteToFileAsRoot(reason, content, path, 0644)
+}
+
 func execute(args ...string) error {
 	_, _, err := crcos.RunWithDefaultLocale(BinPath, args...)
 	return err
@@ -2,14 +2,40 @@ package adminhelper
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/Microsoft/go-winio"
 	"github.com/code-ready/admin-helper/pkg/client"
+	"github.com/code-ready/crc/pkg/os/windows/powershell"
 )
 
+const hostsFileEOL = "\r\n"
+
+// writeHostsFileAsAdmin replaces the hosts file from an elevated powershell, the
+// content is written to a temporary file first
+func writeHostsFileAsAdmin(reason, path, content string) error {
+	file, err := ioutil.TempFile("", "crc-hosts")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.WriteString(content); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	_, _, err = powershell.ExecuteAsAdmin(reason,
+		fmt.Sprintf("Copy-Item -Force -LiteralPath '%s' -Destination '%s'", file.Name(), path))
+	return err
+}
+
 func instance() helper {
 	return Client()
 }
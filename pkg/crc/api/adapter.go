@@ -16,6 +16,8 @@ type AdaptedClient interface {
 	Status() client.ClusterStatusResult
 	Stop() client.Result
 	PowerOff() client.Result
+	Pause() client.Result
+	Resume() client.Result
 }
 
 type Adapter struct {
@@ -83,6 +85,14 @@ func (a *Adapter) Status() client.ClusterStatusResult {
 		OpenshiftVersion: res.OpenshiftVersion,
 		DiskUse:          res.DiskUse,
 		DiskSize:         res.DiskSize,
+		RAMUse:           res.RAMUse,
+		RAMSize:          res.RAMSize,
+		LoadAverage:      res.LoadAverage,
+		Pods:             res.Pods,
+		Containers:       res.Containers,
+		NameServers:      res.NameServers,
+		SearchDomains:    res.SearchDomains,
+		Operators:        res.Operators,
 		Success:          true,
 	}
 }
@@ -113,3 +123,29 @@ func (a *Adapter) PowerOff() client.Result {
 		Success: true,
 	}
 }
+
+func (a *Adapter) Pause() client.Result {
+	if err := a.Underlying.Pause(); err != nil {
+		logging.Error(err)
+		return client.Result{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+	return client.Result{
+		Success: true,
+	}
+}
+
+func (a *Adapter) Resume() client.Result {
+	if err := a.Underlying.Resume(); err != nil {
+		logging.Error(err)
+		return client.Result{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+	return client.Result{
+		Success: true,
+	}
+}
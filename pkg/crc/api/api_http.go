@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/code-ready/crc/pkg/crc/api/client"
 	"github.com/code-ready/crc/pkg/crc/cluster"
@@ -15,8 +16,8 @@ import (
 	"github.com/code-ready/crc/pkg/crc/machine"
 )
 
-func NewMux(config crcConfig.Storage, machine machine.Client, logger Logger, telemetry Telemetry) http.Handler {
-	handler := NewHandler(config, machine, logger, telemetry)
+func NewMux(config crcConfig.Storage, machine machine.Client, logger Logger, telemetry Telemetry, autoStop AutoStop, portForwards PortForwarder) http.Handler {
+	handler := NewHandler(config, machine, logger, telemetry, autoStop, portForwards)
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/start", func(w http.ResponseWriter, r *http.Request) {
@@ -25,10 +26,13 @@ func NewMux(config crcConfig.Storage, machine machine.Client, logger Logger, tel
 		if err != nil {
 			return
 		}
-		if len(data) > 0 {
-			startResult = handler.Start(data)
+		if len(data) == 0 {
+			data = nil
+		}
+		if isAsync(r) {
+			startResult = handler.StartAsync(data)
 		} else {
-			startResult = handler.Start(nil)
+			startResult = handler.Start(data)
 		}
 		sendResponse(w, startResult)
 	})
@@ -37,6 +41,10 @@ func NewMux(config crcConfig.Storage, machine machine.Client, logger Logger, tel
 		if wrongHTTPMethodUsed(r, w, http.MethodGet, http.MethodPost) {
 			return
 		}
+		if isAsync(r) {
+			sendResponse(w, handler.StopAsync())
+			return
+		}
 		stopResult := handler.Stop()
 		sendResponse(w, stopResult)
 	})
@@ -49,6 +57,22 @@ func NewMux(config crcConfig.Storage, machine machine.Client, logger Logger, tel
 		sendResponse(w, stopResult)
 	})
 
+	mux.HandleFunc("/pause", func(w http.ResponseWriter, r *http.Request) {
+		if wrongHTTPMethodUsed(r, w, http.MethodPost) {
+			return
+		}
+		pauseResult := handler.Pause()
+		sendResponse(w, pauseResult)
+	})
+
+	mux.HandleFunc("/resume", func(w http.ResponseWriter, r *http.Request) {
+		if wrongHTTPMethodUsed(r, w, http.MethodPost) {
+			return
+		}
+		resumeResult := handler.Resume()
+		sendResponse(w, resumeResult)
+	})
+
 	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
 		if wrongHTTPMethodUsed(r, w, http.MethodGet) {
 			return
@@ -58,13 +82,29 @@ func NewMux(config crcConfig.Storage, machine machine.Client, logger Logger, tel
 	})
 
 	mux.HandleFunc("/delete", func(w http.ResponseWriter, r *http.Request) {
-		if wrongHTTPMethodUsed(r, w, http.MethodGet, http.MethodDelete) {
+		if wrongHTTPMethodUsed(r, w, http.MethodGet, http.MethodPost, http.MethodDelete) {
+			return
+		}
+		if isAsync(r) {
+			sendResponse(w, handler.DeleteAsync())
 			return
 		}
 		deleteResult := handler.Delete()
 		sendResponse(w, deleteResult)
 	})
 
+	mux.HandleFunc("/operations/", func(w http.ResponseWriter, r *http.Request) {
+		if wrongHTTPMethodUsed(r, w, http.MethodGet, http.MethodDelete) {
+			return
+		}
+		id := strings.TrimPrefix(r.URL.Path, "/operations/")
+		if r.Method == http.MethodDelete {
+			sendResponse(w, handler.CancelOperation(id))
+			return
+		}
+		sendResponse(w, handler.GetOperation(id))
+	})
+
 	mux.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
 		if wrongHTTPMethodUsed(r, w, http.MethodGet) {
 			return
@@ -87,6 +127,8 @@ func NewMux(config crcConfig.Storage, machine machine.Client, logger Logger, tel
 		sendResponse(w, handler.Logs())
 	})
 
+	mux.HandleFunc("/logs/vm/", vmLogsHandler(machine))
+
 	mux.HandleFunc("/telemetry", func(w http.ResponseWriter, r *http.Request) {
 		data, err := verifyRequestAndReadBody(w, r, http.MethodGet, http.MethodPost)
 		if err != nil {
@@ -97,7 +139,112 @@ func NewMux(config crcConfig.Storage, machine machine.Client, logger Logger, tel
 
 	mux.HandleFunc("/pull-secret", pullSecretHandler(config))
 
-	return mux
+	mux.HandleFunc("/events", eventsHandler(handler.events))
+
+	mux.HandleFunc("/autostop", func(w http.ResponseWriter, r *http.Request) {
+		if wrongHTTPMethodUsed(r, w, http.MethodGet) {
+			return
+		}
+		sendResponse(w, handler.GetAutoStopStatus())
+	})
+
+	mux.HandleFunc("/port-forwards", func(w http.ResponseWriter, r *http.Request) {
+		data, err := verifyRequestAndReadBody(w, r, http.MethodGet, http.MethodPost)
+		if err != nil {
+			return
+		}
+		if r.Method == http.MethodPost {
+			sendResponse(w, handler.AddPortForward(data))
+			return
+		}
+		sendResponse(w, handler.ListPortForwards())
+	})
+
+	mux.HandleFunc("/port-forwards/", func(w http.ResponseWriter, r *http.Request) {
+		if wrongHTTPMethodUsed(r, w, http.MethodDelete) {
+			return
+		}
+		sendResponse(w, handler.RemovePortForward(strings.TrimPrefix(r.URL.Path, "/port-forwards/")))
+	})
+
+	return trackActivity(mux, autoStop)
+}
+
+// isAsync returns true when the client asked to run the request in the
+// background with ?async=true, an operation is returned instead of the result
+func isAsync(r *http.Request) bool {
+	return r.URL.Query().Get("async") == "true"
+}
+
+// trackActivity reports the API calls to the auto-stop monitor, except for
+// the endpoints which are polled by the tray and other clients
+func trackActivity(next http.Handler, autoStop AutoStop) http.Handler {
+	passive := map[string]bool{
+		"/status":        true,
+		"/version":       true,
+		"/logs":          true,
+		"/events":        true,
+		"/autostop":      true,
+		"/webconsoleurl": true,
+		"/pull-secret":   true,
+		"/telemetry":     true,
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !passive[r.URL.Path] && !strings.HasPrefix(r.URL.Path, "/operations/") && !strings.HasPrefix(r.URL.Path, "/logs/") {
+			autoStop.Touch()
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// vmLogsHandler streams the journal of a unit of the VM, the query parameters
+// are follow=true and since=<duration>
+func vmLogsHandler(machine machine.Client) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if wrongHTTPMethodUsed(r, w, http.MethodGet) {
+			return
+		}
+		unit := strings.TrimPrefix(r.URL.Path, "/logs/vm/")
+		follow := r.URL.Query().Get("follow") == "true"
+		var since time.Duration
+		if value := r.URL.Query().Get("since"); value != "" {
+			var err error
+			since, err = time.ParseDuration(value)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("Invalid duration: %s", value), http.StatusBadRequest)
+				return
+			}
+		}
+
+		writer := &flushWriter{writer: w}
+		if flusher, ok := w.(http.Flusher); ok {
+			writer.flusher = flusher
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if err := machine.VMLogs(r.Context(), unit, since, follow, writer); err != nil {
+			if !writer.written {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			logging.Debugf("Cannot send the logs of %s: %v", unit, err)
+		}
+	}
+}
+
+// flushWriter sends every write to the client as soon as it is done
+type flushWriter struct {
+	writer  io.Writer
+	flusher http.Flusher
+	written bool
+}
+
+func (w *flushWriter) Write(p []byte) (int, error) {
+	w.written = true
+	n, err := w.writer.Write(p)
+	if w.flusher != nil {
+		w.flusher.Flush()
+	}
+	return n, err
 }
 
 func pullSecretHandler(config crcConfig.Storage) func(w http.ResponseWriter, r *http.Request) {
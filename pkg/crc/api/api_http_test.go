@@ -1,18 +1,25 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	apiClient "github.com/code-ready/crc/pkg/crc/api/client"
+	"github.com/code-ready/crc/pkg/crc/cluster"
 	crcConfig "github.com/code-ready/crc/pkg/crc/config"
 	"github.com/code-ready/crc/pkg/crc/constants"
+	"github.com/code-ready/crc/pkg/crc/machine"
 	"github.com/code-ready/crc/pkg/crc/machine/fakemachine"
 	"github.com/code-ready/crc/pkg/crc/machine/types"
+	"github.com/code-ready/crc/pkg/crc/portforward"
 	"github.com/code-ready/crc/pkg/crc/version"
 	"github.com/stretchr/testify/assert"
 )
@@ -21,7 +28,7 @@ func TestHTTPApi(t *testing.T) {
 	fakeMachine := fakemachine.NewClient()
 	config := setupNewInMemoryConfig()
 
-	ts := httptest.NewServer(NewMux(config, fakeMachine, &mockLogger{}, &mockTelemetry{}))
+	ts := httptest.NewServer(NewMux(config, fakeMachine, &mockLogger{}, &mockTelemetry{}, &mockAutoStop{}, &mockPortForwarder{}))
 	defer ts.Close()
 
 	client := apiClient.New(http.DefaultClient, ts.URL)
@@ -48,7 +55,27 @@ func TestHTTPApi(t *testing.T) {
 			OpenshiftVersion: "4.5.1",
 			DiskUse:          int64(10000000000),
 			DiskSize:         int64(20000000000),
-			Success:          true,
+			RAMUse:           6000000000,
+			RAMSize:          9000000000,
+			LoadAverage:      []float64{1.52, 0.98, 0.61},
+			Pods:             62,
+			Containers:       81,
+			NameServers:      []string{"10.88.0.8", "192.168.130.1"},
+			SearchDomains:    []string{"crc.testing"},
+			Operators: []cluster.OperatorStatus{
+				{
+					Name:               "authentication",
+					Available:          true,
+					LastTransitionTime: time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC),
+				},
+				{
+					Name:               "ingress",
+					Available:          true,
+					Message:            "desired and current number of IngressControllers are equal",
+					LastTransitionTime: time.Date(2021, 6, 1, 10, 30, 0, 0, time.UTC),
+				},
+			},
+			Success: true,
 		},
 		statusResult,
 	)
@@ -86,6 +113,28 @@ func TestHTTPApi(t *testing.T) {
 		stopResult,
 	)
 
+	pauseResult, err := client.Pause()
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		apiClient.Result{
+			Success: true,
+			Error:   "",
+		},
+		pauseResult,
+	)
+
+	resumeResult, err := client.Resume()
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		apiClient.Result{
+			Success: true,
+			Error:   "",
+		},
+		resumeResult,
+	)
+
 	deleteResult, err := client.Delete()
 	assert.NoError(t, err)
 	assert.Equal(
@@ -128,12 +177,138 @@ func TestHTTPApi(t *testing.T) {
 	)
 }
 
+func TestOperations(t *testing.T) {
+	fakeMachine := fakemachine.NewClient()
+	config := setupNewInMemoryConfig()
+
+	ts := httptest.NewServer(NewMux(config, fakeMachine, &mockLogger{}, &mockTelemetry{}, &mockAutoStop{}, &mockPortForwarder{}))
+	defer ts.Close()
+
+	client := apiClient.New(http.DefaultClient, ts.URL)
+
+	stopOperation, err := client.StopAsync()
+	assert.NoError(t, err)
+	assert.True(t, stopOperation.Success)
+	assert.Equal(t, "stop", stopOperation.Operation.Type)
+	assert.NotEmpty(t, stopOperation.Operation.ID)
+
+	stopOperation, err = client.WaitForOperation(context.Background(), stopOperation.Operation.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, apiClient.OperationSucceeded, stopOperation.Operation.State)
+	var stopResult apiClient.Result
+	assert.NoError(t, json.Unmarshal(stopOperation.Operation.Result, &stopResult))
+	assert.Equal(t, apiClient.Result{Success: true}, stopResult)
+
+	_, err = client.CancelOperation(stopOperation.Operation.ID)
+	assert.Error(t, err)
+
+	_, err = client.GetOperation("unknown")
+	assert.Error(t, err)
+}
+
+func TestCancelStartOperation(t *testing.T) {
+	config := setupNewInMemoryConfig()
+
+	ts := httptest.NewServer(NewMux(config, &blockingMachine{Client: fakemachine.NewClient()}, &mockLogger{}, &mockTelemetry{}, &mockAutoStop{}, &mockPortForwarder{}))
+	defer ts.Close()
+
+	client := apiClient.New(http.DefaultClient, ts.URL)
+
+	startOperation, err := client.StartAsync(apiClient.StartConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, apiClient.OperationRunning, startOperation.Operation.State)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = client.WaitForOperation(ctx, startOperation.Operation.ID)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	_, err = client.CancelOperation(startOperation.Operation.ID)
+	assert.NoError(t, err)
+
+	startOperation, err = client.WaitForOperation(context.Background(), startOperation.Operation.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, apiClient.OperationCancelled, startOperation.Operation.State)
+	assert.Equal(t, "context canceled", startOperation.Operation.Error)
+}
+
+type blockingMachine struct {
+	*fakemachine.Client
+}
+
+func (m *blockingMachine) Start(ctx context.Context, _ types.StartConfig) (*types.StartResult, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestEvents(t *testing.T) {
+	config := setupNewInMemoryConfig()
+
+	ts := httptest.NewServer(NewMux(config, machine.NewSynchronizedMachine(fakemachine.NewClient()), &mockLogger{}, &mockTelemetry{}, &mockAutoStop{}, &mockPortForwarder{}))
+	defer ts.Close()
+
+	client := apiClient.New(http.DefaultClient, ts.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := client.Events(ctx)
+	assert.NoError(t, err)
+
+	_, err = client.Stop()
+	assert.NoError(t, err)
+
+	states := map[string][]string{}
+	timeout := time.After(10 * time.Second)
+	for len(states[apiClient.EventClusterState]) < 2 || len(states[apiClient.EventOpenshiftStatus]) < 1 {
+		select {
+		case event := <-events:
+			states[event.Type] = append(states[event.Type], event.State)
+		case <-timeout:
+			t.Fatalf("timeout waiting for events, received %v", states)
+		}
+	}
+	assert.Equal(t, []string{"Stopping", "Idle"}, states[apiClient.EventClusterState])
+	assert.Equal(t, []string{"Running"}, states[apiClient.EventVMState])
+	assert.Equal(t, []string{"Running"}, states[apiClient.EventOpenshiftStatus])
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestAutoStop(t *testing.T) {
+	config := setupNewInMemoryConfig()
+
+	autoStop := &mockAutoStop{}
+	ts := httptest.NewServer(NewMux(config, fakemachine.NewClient(), &mockLogger{}, &mockTelemetry{}, autoStop, &mockPortForwarder{}))
+	defer ts.Close()
+
+	client := apiClient.New(http.DefaultClient, ts.URL)
+
+	_, err := client.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&autoStop.touched))
+
+	_, err = client.Stop()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&autoStop.touched))
+
+	status, err := client.AutoStopStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, apiClient.AutoStopStatus{
+		Success:   true,
+		Enabled:   true,
+		IdleSince: time.Date(2021, time.June, 1, 10, 0, 0, 0, time.UTC),
+		StopTime:  time.Date(2021, time.June, 1, 11, 0, 0, 0, time.UTC),
+	}, status)
+}
+
 func TestTelemetry(t *testing.T) {
 	fakeMachine := fakemachine.NewClient()
 	config := setupNewInMemoryConfig()
 
 	telemetry := &mockTelemetry{}
-	ts := httptest.NewServer(NewMux(config, fakeMachine, &mockLogger{}, telemetry))
+	ts := httptest.NewServer(NewMux(config, fakeMachine, &mockLogger{}, telemetry, &mockAutoStop{}, &mockPortForwarder{}))
 	defer ts.Close()
 
 	client := apiClient.New(http.DefaultClient, ts.URL)
@@ -152,7 +327,7 @@ func TestPullSecret(t *testing.T) {
 	fakeMachine := fakemachine.NewClient()
 	config := setupNewInMemoryConfig()
 
-	ts := httptest.NewServer(NewMux(config, fakeMachine, &mockLogger{}, &mockTelemetry{}))
+	ts := httptest.NewServer(NewMux(config, fakeMachine, &mockLogger{}, &mockTelemetry{}, &mockAutoStop{}, &mockPortForwarder{}))
 	defer ts.Close()
 
 	client := apiClient.New(http.DefaultClient, ts.URL)
@@ -172,3 +347,67 @@ func TestPullSecret(t *testing.T) {
 
 	assert.Error(t, client.SetPullSecret("{}")) // invalid
 }
+
+func TestPortForwards(t *testing.T) {
+	config := setupNewInMemoryConfig()
+
+	ts := httptest.NewServer(NewMux(config, fakemachine.NewClient(), &mockLogger{}, &mockTelemetry{}, &mockAutoStop{}, &mockPortForwarder{}))
+	defer ts.Close()
+
+	client := apiClient.New(http.DefaultClient, ts.URL)
+
+	assert.NoError(t, client.AddPortForward(portforward.Forward{HostPort: 8080, VMPort: 80}))
+	assert.NoError(t, client.AddPortForward(portforward.Forward{HostPort: 15432, Namespace: "db", Service: "postgres", ServicePort: 5432}))
+	assert.Error(t, client.AddPortForward(portforward.Forward{HostPort: 8080, VMPort: 8080}))
+	assert.Error(t, client.AddPortForward(portforward.Forward{HostPort: 0, VMPort: 80}))
+	assert.Error(t, client.AddPortForward(portforward.Forward{HostPort: 8081, Service: "postgres", ServicePort: 5432}))
+	assert.Equal(t, "8080:80,15432:db/postgres:5432", config.Get(crcConfig.PortForwards).AsString())
+
+	assert.NoError(t, client.RemovePortForward(8080))
+	assert.Error(t, client.RemovePortForward(8080))
+	result, err := client.PortForwards()
+	assert.NoError(t, err)
+	assert.Equal(t, apiClient.PortForwardsResult{
+		Success:  true,
+		Forwards: []portforward.Forward{{HostPort: 15432, Namespace: "db", Service: "postgres", ServicePort: 5432}},
+	}, result)
+	assert.Equal(t, "15432:db/postgres:5432", config.Get(crcConfig.PortForwards).AsString())
+}
+
+func TestPortForwardsKeepsTheForwardsWhichWereNotRestored(t *testing.T) {
+	config := setupNewInMemoryConfig()
+	_, err := config.Set(crcConfig.PortForwards, "9090:90")
+	assert.NoError(t, err)
+
+	ts := httptest.NewServer(NewMux(config, fakemachine.NewClient(), &mockLogger{}, &mockTelemetry{}, &mockAutoStop{}, &mockPortForwarder{}))
+	defer ts.Close()
+
+	client := apiClient.New(http.DefaultClient, ts.URL)
+
+	assert.NoError(t, client.AddPortForward(portforward.Forward{HostPort: 8080, VMPort: 80}))
+	assert.Equal(t, "8080:80,9090:90", config.Get(crcConfig.PortForwards).AsString())
+
+	assert.NoError(t, client.RemovePortForward(9090))
+	assert.Equal(t, "8080:80", config.Get(crcConfig.PortForwards).AsString())
+}
+
+func TestVMLogs(t *testing.T) {
+	fakeMachine := fakemachine.NewClient()
+	ts := httptest.NewServer(NewMux(setupNewInMemoryConfig(), fakeMachine, &mockLogger{}, &mockTelemetry{}, &mockAutoStop{}, &mockPortForwarder{}))
+	defer ts.Close()
+
+	client := apiClient.New(http.DefaultClient, ts.URL)
+
+	logs, err := client.VMLogs("kubelet", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "kubelet started\n", logs)
+
+	res, err := http.Get(ts.URL + "/logs/vm/kubelet?since=yesterday")
+	assert.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	fakeMachine.Failing = true
+	_, err = client.VMLogs("kubelet", 0)
+	assert.Error(t, err)
+}
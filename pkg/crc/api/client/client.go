@@ -1,15 +1,23 @@
 package client
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/portforward"
 )
 
+const operationPollInterval = time.Second
+
 type Client struct {
 	client *http.Client
 	base   string
@@ -68,6 +76,91 @@ func (c *Client) Start(config StartConfig) (StartResult, error) {
 	return sr, nil
 }
 
+// StartAsync starts the cluster in the background and returns the
+// corresponding operation
+func (c *Client) StartAsync(config StartConfig) (OperationResult, error) {
+	var data = new(bytes.Buffer)
+
+	if config != (StartConfig{}) {
+		if err := json.NewEncoder(data).Encode(config); err != nil {
+			return OperationResult{}, fmt.Errorf("Failed to encode data to JSON: %w", err)
+		}
+	}
+	return c.sendOperationRequest("/start?async=true", data)
+}
+
+// StopAsync stops the cluster in the background and returns the
+// corresponding operation
+func (c *Client) StopAsync() (OperationResult, error) {
+	return c.sendOperationRequest("/stop?async=true", nil)
+}
+
+// DeleteAsync deletes the cluster in the background and returns the
+// corresponding operation
+func (c *Client) DeleteAsync() (OperationResult, error) {
+	return c.sendOperationRequest("/delete?async=true", nil)
+}
+
+func (c *Client) GetOperation(id string) (OperationResult, error) {
+	var or = OperationResult{}
+	body, err := c.sendGetRequest(fmt.Sprintf("/operations/%s", id))
+	if err != nil {
+		return or, err
+	}
+	err = json.Unmarshal(body, &or)
+	if err != nil {
+		return or, err
+	}
+	return or, nil
+}
+
+// CancelOperation requests the cancellation of a running operation, only
+// start operations can be cancelled
+func (c *Client) CancelOperation(id string) (OperationResult, error) {
+	var or = OperationResult{}
+	body, err := c.sendDeleteRequest(fmt.Sprintf("/operations/%s", id))
+	if err != nil {
+		return or, err
+	}
+	err = json.Unmarshal(body, &or)
+	if err != nil {
+		return or, err
+	}
+	return or, nil
+}
+
+// WaitForOperation polls the daemon until the operation is finished or ctx
+// is done, the operation keeps running in the daemon in the latter case
+func (c *Client) WaitForOperation(ctx context.Context, id string) (OperationResult, error) {
+	for {
+		or, err := c.GetOperation(id)
+		if err != nil {
+			return or, err
+		}
+		if or.Operation.State != OperationRunning {
+			return or, nil
+		}
+		select {
+		case <-ctx.Done():
+			return or, ctx.Err()
+		case <-time.After(operationPollInterval):
+		}
+	}
+}
+
+func (c *Client) sendOperationRequest(url string, data io.Reader) (OperationResult, error) {
+	var or = OperationResult{}
+	body, err := c.sendPostRequest(url, data)
+	if err != nil {
+		return or, err
+	}
+	err = json.Unmarshal(body, &or)
+	if err != nil {
+		return or, err
+	}
+	return or, nil
+}
+
 func (c *Client) Stop() (Result, error) {
 	var sr = Result{}
 	body, err := c.sendGetRequest("/stop")
@@ -81,6 +174,32 @@ func (c *Client) Stop() (Result, error) {
 	return sr, nil
 }
 
+func (c *Client) Pause() (Result, error) {
+	var pr = Result{}
+	body, err := c.sendPostRequest("/pause", nil)
+	if err != nil {
+		return pr, err
+	}
+	err = json.Unmarshal(body, &pr)
+	if err != nil {
+		return pr, err
+	}
+	return pr, nil
+}
+
+func (c *Client) Resume() (Result, error) {
+	var rr = Result{}
+	body, err := c.sendPostRequest("/resume", nil)
+	if err != nil {
+		return rr, err
+	}
+	err = json.Unmarshal(body, &rr)
+	if err != nil {
+		return rr, err
+	}
+	return rr, nil
+}
+
 func (c *Client) Delete() (Result, error) {
 	var dr = Result{}
 	body, err := c.sendGetRequest("/delete")
@@ -175,6 +294,60 @@ func (c *Client) UnsetConfig(configs []string) (SetOrUnsetConfigResult, error) {
 	return ucr, nil
 }
 
+func (c *Client) AutoStopStatus() (AutoStopStatus, error) {
+	var as = AutoStopStatus{}
+	body, err := c.sendGetRequest("/autostop")
+	if err != nil {
+		return as, err
+	}
+	err = json.Unmarshal(body, &as)
+	if err != nil {
+		return as, err
+	}
+	return as, nil
+}
+
+func (c *Client) PortForwards() (PortForwardsResult, error) {
+	var pfr = PortForwardsResult{}
+	body, err := c.sendGetRequest("/port-forwards")
+	if err != nil {
+		return pfr, err
+	}
+	err = json.Unmarshal(body, &pfr)
+	if err != nil {
+		return pfr, err
+	}
+	return pfr, nil
+}
+
+// VMLogs returns the journal of a unit of the VM, since is the age of the
+// oldest entry, 0 for all of them
+func (c *Client) VMLogs(unit string, since time.Duration) (string, error) {
+	url := fmt.Sprintf("/logs/vm/%s", unit)
+	if since > 0 {
+		url = fmt.Sprintf("%s?since=%s", url, since)
+	}
+	body, err := c.sendGetRequest(url)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+func (c *Client) AddPortForward(forward portforward.Forward) error {
+	data, err := json.Marshal(forward)
+	if err != nil {
+		return fmt.Errorf("Failed to encode data to JSON: %w", err)
+	}
+	_, err = c.sendPostRequest("/port-forwards", bytes.NewReader(data))
+	return err
+}
+
+func (c *Client) RemovePortForward(hostPort int) error {
+	_, err := c.sendDeleteRequest(fmt.Sprintf("/port-forwards/%d", hostPort))
+	return err
+}
+
 func (c *Client) Telemetry(action string) error {
 	data, err := json.Marshal(TelemetryRequest{
 		Action: action,
@@ -215,6 +388,49 @@ func (c *Client) SetPullSecret(data string) error {
 	return nil
 }
 
+// Events subscribes to the server-sent events of the daemon. The returned
+// channel is closed when ctx is cancelled or when the connection is lost.
+func (c *Client) Events(ctx context.Context) (<-chan Event, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s%s", c.base, "/events"), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("Error occurred sending GET request to : %s : %d", "/events", res.StatusCode)
+	}
+
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		defer res.Body.Close()
+
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			data := strings.TrimPrefix(scanner.Text(), "data: ")
+			if data == scanner.Text() {
+				continue
+			}
+			var event Event
+			if err := json.Unmarshal([]byte(data), &event); err != nil {
+				continue
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
 func (c *Client) sendGetRequest(url string) ([]byte, error) {
 	res, err := c.client.Get(fmt.Sprintf("%s%s", c.base, url))
 	if err != nil {
@@ -233,6 +449,29 @@ func (c *Client) sendGetRequest(url string) ([]byte, error) {
 	return body, nil
 }
 
+func (c *Client) sendDeleteRequest(url string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s%s", c.base, url), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error occurred sending DELETE request to : %s : %d", url, res.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Unknown error reading response: %w", err)
+	}
+	return body, nil
+}
+
 func (c *Client) sendPostRequest(url string, data io.Reader) ([]byte, error) {
 	req, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.base, url), data)
 	if err != nil {
@@ -1,7 +1,12 @@
 package client
 
 import (
+	"encoding/json"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/cluster"
 	"github.com/code-ready/crc/pkg/crc/machine/types"
+	"github.com/code-ready/crc/pkg/crc/portforward"
 )
 
 type VersionResult struct {
@@ -30,6 +35,14 @@ type ClusterStatusResult struct {
 	OpenshiftVersion string
 	DiskUse          int64
 	DiskSize         int64
+	RAMUse           int64
+	RAMSize          int64
+	LoadAverage      []float64
+	Pods             int
+	Containers       int
+	NameServers      []string
+	SearchDomains    []string
+	Operators        []cluster.OperatorStatus
 	Error            string
 	Success          bool
 }
@@ -67,8 +80,69 @@ type GetOrUnsetConfigRequest struct {
 	Properties []string `json:"properties"`
 }
 
+const (
+	OperationRunning   = "Running"
+	OperationSucceeded = "Succeeded"
+	OperationFailed    = "Failed"
+	OperationCancelled = "Cancelled"
+)
+
+// Operation describes a start, stop or delete running in the background.
+// Result holds the StartResult or Result of the operation once it is finished.
+type Operation struct {
+	ID        string
+	Type      string
+	State     string
+	Phase     string
+	StartTime time.Time
+	EndTime   time.Time
+	Result    json.RawMessage
+	Error     string
+}
+
+type OperationResult struct {
+	Success   bool
+	Error     string
+	Operation Operation
+}
+
+const (
+	// EventClusterState reports the operation run by the daemon: Idle,
+	// Starting, Stopping, Deleting, Pausing or Resuming
+	EventClusterState    = "cluster-state"
+	EventVMState         = "vm-state"
+	EventOpenshiftStatus = "openshift-status"
+	EventLog             = "log"
+)
+
+// Event is sent by the /events endpoint. State is set for the state events,
+// Message for log events and for VM state errors.
+type Event struct {
+	Type    string
+	Time    time.Time
+	State   string
+	Message string
+}
+
+// AutoStopStatus describes when the daemon stops an idle cluster. Warning is
+// set once the stop is about to happen.
+type AutoStopStatus struct {
+	Success   bool
+	Error     string
+	Enabled   bool
+	IdleSince time.Time
+	StopTime  time.Time
+	Warning   string
+}
+
 type TelemetryRequest struct {
 	Action string `json:"action"`
 	Source string `json:"source"`
 	Status string `json:"status"`
 }
+
+type PortForwardsResult struct {
+	Success  bool
+	Error    string
+	Forwards []portforward.Forward
+}
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/api/client"
+	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/code-ready/crc/pkg/crc/machine/state"
+)
+
+const (
+	statusPollInterval   = 5 * time.Second
+	subscriberBufferSize = 100
+)
+
+// stateNotifier is implemented by machine clients which can report the
+// operation they are running, such as machine.Synchronized
+type stateNotifier interface {
+	AddStateListener(listener func(machine.State))
+}
+
+// eventBroker fans out state changes and log messages to the subscribers of
+// the /events endpoint. The VM and OpenShift status are polled once for all
+// subscribers, and only while there is at least one of them.
+type eventBroker struct {
+	poller *machine.StatusPoller
+
+	lock        sync.Mutex
+	subscribers map[chan client.Event]struct{}
+	// last event of each state type, replayed to new subscribers
+	lastStates map[string]client.Event
+	// stops the status updates when the last subscriber leaves
+	stopPolling func()
+}
+
+func newEventBroker(machineClient machine.Client, logger Logger) *eventBroker {
+	broker := &eventBroker{
+		poller:      machine.NewStatusPoller(machineClient, statusPollInterval),
+		subscribers: make(map[chan client.Event]struct{}),
+		lastStates:  make(map[string]client.Event),
+	}
+	if notifier, ok := machineClient.(stateNotifier); ok {
+		notifier.AddStateListener(func(st machine.State) {
+			broker.publish(client.Event{
+				Type:  client.EventClusterState,
+				State: string(st),
+			})
+			broker.poller.Refresh()
+		})
+	}
+	logger.AddListener(func(message string) {
+		broker.publish(client.Event{
+			Type:    client.EventLog,
+			Message: message,
+		})
+	})
+	return broker
+}
+
+func (b *eventBroker) subscribe() (<-chan client.Event, func()) {
+	ch := make(chan client.Event, subscriberBufferSize)
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, eventType := range []string{client.EventClusterState, client.EventVMState, client.EventOpenshiftStatus} {
+		if event, ok := b.lastStates[eventType]; ok {
+			ch <- event
+		}
+	}
+	b.subscribers[ch] = struct{}{}
+	if b.stopPolling == nil {
+		updates, stop := b.poller.Subscribe()
+		b.stopPolling = stop
+		go b.publishStatusUpdates(updates)
+	}
+
+	return ch, func() {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		delete(b.subscribers, ch)
+		close(ch)
+		if len(b.subscribers) == 0 && b.stopPolling != nil {
+			b.stopPolling()
+			b.stopPolling = nil
+		}
+	}
+}
+
+// publish sends event to all the subscribers. State events identical to the
+// previous one of the same type are dropped. It must not log anything as it
+// is called for each log message.
+func (b *eventBroker) publish(event client.Event) {
+	event.Time = time.Now()
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if event.Type != client.EventLog {
+		if last, ok := b.lastStates[event.Type]; ok && last.State == event.State && last.Message == event.Message {
+			return
+		}
+		b.lastStates[event.Type] = event
+	}
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			// slow subscriber, drop the event rather than blocking the daemon
+		}
+	}
+}
+
+// publishStatusUpdates runs until the poller closes updates
+func (b *eventBroker) publishStatusUpdates(updates <-chan machine.StatusUpdate) {
+	for update := range updates {
+		if update.Err != nil {
+			b.publish(client.Event{
+				Type:    client.EventVMState,
+				State:   string(state.Error),
+				Message: update.Err.Error(),
+			})
+			continue
+		}
+		b.publish(client.Event{
+			Type:  client.EventVMState,
+			State: string(update.Status.CrcStatus),
+		})
+		b.publish(client.Event{
+			Type:  client.EventOpenshiftStatus,
+			State: string(update.Status.OpenshiftStatus),
+		})
+	}
+}
+
+func eventsHandler(broker *eventBroker) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if wrongHTTPMethodUsed(r, w, http.MethodGet) {
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+
+		events, unsubscribe := broker.subscribe()
+		defer unsubscribe()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case event := <-events:
+				if err := writeEvent(w, event); err != nil {
+					logging.Debugf("Cannot send event: %v", err)
+					return
+				}
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, event client.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+	return err
+}
@@ -4,14 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/code-ready/crc/pkg/crc/api/client"
+	"github.com/code-ready/crc/pkg/crc/autostop"
 	"github.com/code-ready/crc/pkg/crc/cluster"
 	crcConfig "github.com/code-ready/crc/pkg/crc/config"
 	"github.com/code-ready/crc/pkg/crc/errors"
 	"github.com/code-ready/crc/pkg/crc/logging"
 	"github.com/code-ready/crc/pkg/crc/machine"
 	"github.com/code-ready/crc/pkg/crc/machine/types"
+	"github.com/code-ready/crc/pkg/crc/portforward"
 	"github.com/code-ready/crc/pkg/crc/preflight"
 	"github.com/code-ready/crc/pkg/crc/version"
 )
@@ -21,16 +24,33 @@ type Handler struct {
 	MachineClient AdaptedClient
 	Config        crcConfig.Storage
 	Telemetry     Telemetry
+	AutoStop      AutoStop
+	PortForwards  PortForwarder
+
+	operations *operationStore
+	events     *eventBroker
 }
 
 type Logger interface {
 	Messages() []string
+	AddListener(listener func(message string))
 }
 
 type Telemetry interface {
 	UploadAction(action, source, status string) error
 }
 
+type AutoStop interface {
+	Touch()
+	Status() autostop.Status
+}
+
+type PortForwarder interface {
+	Add(forward portforward.Forward) error
+	Remove(hostPort int) error
+	List() []portforward.Forward
+}
+
 func (h *Handler) Logs() string {
 	return encodeStructToJSON(&loggerResult{
 		Success:  true,
@@ -38,14 +58,18 @@ func (h *Handler) Logs() string {
 	})
 }
 
-func NewHandler(config crcConfig.Storage, machine machine.Client, logger Logger, telemetry Telemetry) *Handler {
+func NewHandler(config crcConfig.Storage, machine machine.Client, logger Logger, telemetry Telemetry, autoStop AutoStop, portForwards PortForwarder) *Handler {
 	return &Handler{
 		MachineClient: &Adapter{
 			Underlying: machine,
 		},
-		Config:    config,
-		Logger:    logger,
-		Telemetry: telemetry,
+		Config:       config,
+		Logger:       logger,
+		Telemetry:    telemetry,
+		AutoStop:     autoStop,
+		PortForwards: portForwards,
+		operations:   newOperationStore(),
+		events:       newEventBroker(machine, logger),
 	}
 }
 
@@ -64,27 +88,118 @@ func (h *Handler) PowerOff() string {
 	return encodeStructToJSON(commandResult)
 }
 
+func (h *Handler) Pause() string {
+	commandResult := h.MachineClient.Pause()
+	return encodeStructToJSON(commandResult)
+}
+
+func (h *Handler) Resume() string {
+	commandResult := h.MachineClient.Resume()
+	return encodeStructToJSON(commandResult)
+}
+
 func (h *Handler) Start(args json.RawMessage) string {
+	parsedArgs, err := parseStartArgs(args)
+	if err != nil {
+		return encodeStructToJSON(&client.StartResult{
+			Success: false,
+			Error:   err.Error(),
+		})
+	}
+	status := h.start(context.Background(), parsedArgs, nil)
+	return encodeStructToJSON(status)
+}
+
+func (h *Handler) start(ctx context.Context, args client.StartConfig, progress func(types.StartEvent)) client.StartResult {
+	if err := preflight.StartPreflightChecks(h.Config); err != nil {
+		return client.StartResult{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+
+	startConfig := getStartConfig(h.Config, args)
+	startConfig.Progress = progress
+	return h.MachineClient.Start(ctx, startConfig)
+}
+
+func parseStartArgs(args json.RawMessage) (client.StartConfig, error) {
 	var parsedArgs client.StartConfig
 	if args != nil {
 		if err := json.Unmarshal(args, &parsedArgs); err != nil {
-			return encodeStructToJSON(&client.StartResult{
-				Success: false,
-				Error:   fmt.Sprintf("Incorrect arguments given: %s", err.Error()),
-			})
+			return parsedArgs, fmt.Errorf("Incorrect arguments given: %s", err.Error())
 		}
 	}
-	if err := preflight.StartPreflightChecks(h.Config); err != nil {
-		startErr := &client.StartResult{
+	return parsedArgs, nil
+}
+
+// StartAsync starts the cluster in the background, the returned operation
+// can be cancelled
+func (h *Handler) StartAsync(args json.RawMessage) string {
+	parsedArgs, err := parseStartArgs(args)
+	if err != nil {
+		return encodeStructToJSON(&client.OperationResult{
 			Success: false,
 			Error:   err.Error(),
-		}
-		return encodeStructToJSON(startErr)
+		})
 	}
+	operation := h.operations.run("start", true, func(ctx context.Context, setPhase func(string)) (interface{}, error) {
+		result := h.start(ctx, parsedArgs, func(event types.StartEvent) {
+			if event.Outcome == types.PhaseInProgress {
+				setPhase(string(event.Phase))
+			}
+		})
+		return result, resultError(result.Success, result.Error)
+	})
+	return encodeStructToJSON(&client.OperationResult{
+		Success:   true,
+		Operation: operation,
+	})
+}
 
-	startConfig := getStartConfig(h.Config, parsedArgs)
-	status := h.MachineClient.Start(context.Background(), startConfig)
-	return encodeStructToJSON(status)
+func (h *Handler) StopAsync() string {
+	operation := h.operations.run("stop", false, func(context.Context, func(string)) (interface{}, error) {
+		result := h.MachineClient.Stop()
+		return result, resultError(result.Success, result.Error)
+	})
+	return encodeStructToJSON(&client.OperationResult{
+		Success:   true,
+		Operation: operation,
+	})
+}
+
+func (h *Handler) DeleteAsync() string {
+	operation := h.operations.run("delete", false, func(context.Context, func(string)) (interface{}, error) {
+		result := h.MachineClient.Delete()
+		return result, resultError(result.Success, result.Error)
+	})
+	return encodeStructToJSON(&client.OperationResult{
+		Success:   true,
+		Operation: operation,
+	})
+}
+
+func (h *Handler) GetOperation(id string) string {
+	operation, err := h.operations.get(id)
+	return encodeStructToJSON(toOperationResult(operation, err))
+}
+
+func (h *Handler) CancelOperation(id string) string {
+	operation, err := h.operations.cancel(id)
+	return encodeStructToJSON(toOperationResult(operation, err))
+}
+
+func toOperationResult(operation client.Operation, err error) *client.OperationResult {
+	if err != nil {
+		return &client.OperationResult{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+	return &client.OperationResult{
+		Success:   true,
+		Operation: operation,
+	}
 }
 
 func getStartConfig(cfg crcConfig.Storage, args client.StartConfig) types.StartConfig {
@@ -93,12 +208,87 @@ func getStartConfig(cfg crcConfig.Storage, args client.StartConfig) types.StartC
 		Memory:            cfg.Get(crcConfig.Memory).AsInt(),
 		DiskSize:          cfg.Get(crcConfig.DiskSize).AsInt(),
 		CPUs:              cfg.Get(crcConfig.CPUs).AsInt(),
-		NameServer:        cfg.Get(crcConfig.NameServer).AsString(),
+		MaxMemory:         cfg.Get(crcConfig.MaxMemory).AsInt(),
+		MaxCPUs:           cfg.Get(crcConfig.MaxCPUs).AsInt(),
+		DataDiskSize:      cfg.Get(crcConfig.DataDiskSize).AsInt(),
+		SharedDirs:        crcConfig.GetSharedDirs(cfg),
+		NameServers:       crcConfig.GetNameServers(cfg),
+		SearchDomains:     crcConfig.GetSearchDomains(cfg),
 		PullSecret:        cluster.NewNonInteractivePullSecretLoader(cfg, args.PullSecretFile),
 		KubeAdminPassword: cfg.Get(crcConfig.KubeAdminPassword).AsString(),
 	}
 }
 
+func (h *Handler) GetAutoStopStatus() string {
+	status := h.AutoStop.Status()
+	return encodeStructToJSON(&client.AutoStopStatus{
+		Success:   true,
+		Enabled:   status.Enabled,
+		IdleSince: status.IdleSince,
+		StopTime:  status.StopTime,
+		Warning:   status.Warning,
+	})
+}
+
+func (h *Handler) ListPortForwards() string {
+	return encodeStructToJSON(&client.PortForwardsResult{
+		Success:  true,
+		Forwards: h.PortForwards.List(),
+	})
+}
+
+func (h *Handler) AddPortForward(data []byte) string {
+	var forward portforward.Forward
+	if err := json.Unmarshal(data, &forward); err != nil {
+		return encodeErrorToJSON(fmt.Sprintf("Failed to parse port forward: %v", err))
+	}
+	if err := forward.Validate(); err != nil {
+		return encodeErrorToJSON(fmt.Sprintf("Invalid port forward: %v", err))
+	}
+	if err := h.PortForwards.Add(forward); err != nil {
+		return encodeErrorToJSON(err.Error())
+	}
+	return h.savePortForwards(func(forwards []portforward.Forward) ([]portforward.Forward, error) {
+		forwards, _ = portforward.RemoveFromList(forwards, forward.HostPort)
+		return portforward.AddToList(forwards, forward), nil
+	})
+}
+
+func (h *Handler) RemovePortForward(hostPort string) string {
+	port, err := strconv.Atoi(hostPort)
+	if err != nil {
+		return encodeErrorToJSON(fmt.Sprintf("Invalid host port: %s", hostPort))
+	}
+	removeErr := h.PortForwards.Remove(port)
+	return h.savePortForwards(func(forwards []portforward.Forward) ([]portforward.Forward, error) {
+		forwards, stored := portforward.RemoveFromList(forwards, port)
+		// a stored forward which could not be restored is only in the configuration
+		if removeErr != nil && !stored {
+			return nil, removeErr
+		}
+		return forwards, nil
+	})
+}
+
+// savePortForwards updates the forwards stored in the configuration, the
+// daemon restores them when it starts. The stored forwards are updated
+// rather than replaced by the active ones so that the forwards which could
+// not be restored, because their port was in use for instance, are kept.
+func (h *Handler) savePortForwards(update func([]portforward.Forward) ([]portforward.Forward, error)) string {
+	forwards, err := portforward.ParseList(h.Config.Get(crcConfig.PortForwards).AsString())
+	if err != nil {
+		return encodeErrorToJSON(fmt.Sprintf("Cannot parse the stored port forwards: %v", err))
+	}
+	forwards, err = update(forwards)
+	if err != nil {
+		return encodeErrorToJSON(err.Error())
+	}
+	if _, err := h.Config.Set(crcConfig.PortForwards, portforward.FormatList(forwards)); err != nil {
+		return encodeErrorToJSON(err.Error())
+	}
+	return encodeStructToJSON(client.Result{Success: true})
+}
+
 func (h *Handler) GetVersion() string {
 	v := &client.VersionResult{
 		CrcVersion:       version.GetCRCVersion(),
@@ -1,9 +1,14 @@
 package api
 
 import (
+	"fmt"
 	"strings"
+	"sync/atomic"
+	"time"
 
+	"github.com/code-ready/crc/pkg/crc/autostop"
 	"github.com/code-ready/crc/pkg/crc/config"
+	"github.com/code-ready/crc/pkg/crc/portforward"
 	"github.com/code-ready/crc/pkg/crc/preflight"
 )
 
@@ -44,6 +49,25 @@ func (*mockLogger) Messages() []string {
 	return []string{"message 1", "message 2", "message 3"}
 }
 
+func (*mockLogger) AddListener(func(message string)) {
+}
+
+type mockAutoStop struct {
+	touched int32
+}
+
+func (m *mockAutoStop) Touch() {
+	atomic.AddInt32(&m.touched, 1)
+}
+
+func (m *mockAutoStop) Status() autostop.Status {
+	return autostop.Status{
+		Enabled:   true,
+		IdleSince: time.Date(2021, time.June, 1, 10, 0, 0, 0, time.UTC),
+		StopTime:  time.Date(2021, time.June, 1, 11, 0, 0, 0, time.UTC),
+	}
+}
+
 type mockTelemetry struct {
 	actions []string
 }
@@ -52,3 +76,31 @@ func (m *mockTelemetry) UploadAction(action, _, _ string) error {
 	m.actions = append(m.actions, action)
 	return nil
 }
+
+type mockPortForwarder struct {
+	forwards []portforward.Forward
+}
+
+func (m *mockPortForwarder) Add(forward portforward.Forward) error {
+	for _, existing := range m.forwards {
+		if existing.HostPort == forward.HostPort {
+			return fmt.Errorf("host port %d is already forwarded", forward.HostPort)
+		}
+	}
+	m.forwards = append(m.forwards, forward)
+	return nil
+}
+
+func (m *mockPortForwarder) Remove(hostPort int) error {
+	for i, existing := range m.forwards {
+		if existing.HostPort == hostPort {
+			m.forwards = append(m.forwards[:i], m.forwards[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("host port %d is not forwarded", hostPort)
+}
+
+func (m *mockPortForwarder) List() []portforward.Forward {
+	return append([]portforward.Forward{}, m.forwards...)
+}
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/api/client"
+	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/pborman/uuid"
+)
+
+// maxFinishedOperations is the number of finished operations kept around so
+// that clients can still query their result
+const maxFinishedOperations = 20
+
+type operationFunc func(ctx context.Context, setPhase func(phase string)) (interface{}, error)
+
+type operation struct {
+	details client.Operation
+	cancel  context.CancelFunc
+}
+
+type operationStore struct {
+	lock       sync.Mutex
+	operations map[string]*operation
+	// IDs of the operations in creation order
+	ids []string
+}
+
+func newOperationStore() *operationStore {
+	return &operationStore{
+		operations: make(map[string]*operation),
+	}
+}
+
+// run executes fn in the background and returns the newly created operation.
+// The context given to fn is only cancelled through cancel when cancellable
+// is true.
+func (s *operationStore) run(operationType string, cancellable bool, fn operationFunc) client.Operation {
+	ctx, cancel := context.WithCancel(context.Background())
+	op := &operation{
+		details: client.Operation{
+			ID:        uuid.New(),
+			Type:      operationType,
+			State:     client.OperationRunning,
+			StartTime: time.Now(),
+		},
+	}
+	if cancellable {
+		op.cancel = cancel
+	}
+
+	s.lock.Lock()
+	s.operations[op.details.ID] = op
+	s.ids = append(s.ids, op.details.ID)
+	s.pruneUnlocked()
+	details := op.details
+	s.lock.Unlock()
+
+	go func() {
+		defer cancel()
+		result, err := fn(ctx, func(phase string) {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+			op.details.Phase = phase
+		})
+		s.finish(ctx, op, result, err)
+	}()
+
+	return details
+}
+
+func (s *operationStore) finish(ctx context.Context, op *operation, result interface{}, err error) {
+	encodedResult, encodeErr := json.Marshal(result)
+	if encodeErr != nil {
+		logging.Errorf("Cannot encode the result of operation %s: %v", op.details.ID, encodeErr)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	op.details.EndTime = time.Now()
+	op.details.Result = encodedResult
+	switch {
+	case err == nil:
+		op.details.State = client.OperationSucceeded
+	case ctx.Err() != nil:
+		op.details.State = client.OperationCancelled
+		op.details.Error = err.Error()
+	default:
+		op.details.State = client.OperationFailed
+		op.details.Error = err.Error()
+	}
+}
+
+func (s *operationStore) get(id string) (client.Operation, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	op, ok := s.operations[id]
+	if !ok {
+		return client.Operation{}, fmt.Errorf("Unknown operation: %s", id)
+	}
+	return op.details, nil
+}
+
+// cancel requests the cancellation of a running operation, the operation is
+// in the cancelled state once it returns
+func (s *operationStore) cancel(id string) (client.Operation, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	op, ok := s.operations[id]
+	if !ok {
+		return client.Operation{}, fmt.Errorf("Unknown operation: %s", id)
+	}
+	if op.details.State != client.OperationRunning {
+		return op.details, fmt.Errorf("Operation %s is not running", id)
+	}
+	if op.cancel == nil {
+		return op.details, fmt.Errorf("Operation %s (%s) cannot be cancelled", id, op.details.Type)
+	}
+	op.cancel()
+	return op.details, nil
+}
+
+// pruneUnlocked forgets the oldest finished operations.
+// s.lock must be locked before calling this function
+func (s *operationStore) pruneUnlocked() {
+	finished := 0
+	for _, id := range s.ids {
+		if s.operations[id].details.State != client.OperationRunning {
+			finished++
+		}
+	}
+
+	var ids []string
+	for _, id := range s.ids {
+		if finished > maxFinishedOperations && s.operations[id].details.State != client.OperationRunning {
+			delete(s.operations, id)
+			finished--
+			continue
+		}
+		ids = append(ids, id)
+	}
+	s.ids = ids
+}
+
+// resultError turns the Success and Error fields of a result into an error
+func resultError(success bool, message string) error {
+	if success {
+		return nil
+	}
+	return errors.New(message)
+}
@@ -0,0 +1,266 @@
+package autostop
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/docker/go-units"
+)
+
+const (
+	checkInterval = 30 * time.Second
+	// warningPeriod is how long before the stop a warning is emitted
+	warningPeriod = 5 * time.Minute
+)
+
+// Status describes the auto-stop state of the daemon. Warning is set once the
+// cluster is about to be stopped.
+type Status struct {
+	Enabled   bool
+	IdleSince time.Time
+	StopTime  time.Time
+	Warning   string
+}
+
+// Monitor stops the cluster after it has been idle for the configured
+// duration. Activity is recorded with Touch or detected by polling the
+// activity sources.
+type Monitor struct {
+	timeout   func() time.Duration
+	isRunning func() (bool, error)
+	stop      func() error
+	now       func() time.Time
+
+	lock         sync.Mutex
+	sources      []func() bool
+	lastActivity time.Time
+	warning      string
+}
+
+// NewMonitor creates a monitor calling stop once the cluster has been idle
+// for timeout. A zero timeout disables the auto-stop.
+func NewMonitor(timeout func() time.Duration, isRunning func() (bool, error), stop func() error) *Monitor {
+	return &Monitor{
+		timeout:      timeout,
+		isRunning:    isRunning,
+		stop:         stop,
+		now:          time.Now,
+		lastActivity: time.Now(),
+	}
+}
+
+// AddActivitySource registers a function reporting if the cluster was used
+// since its previous call
+func (m *Monitor) AddActivitySource(source func() bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.sources = append(m.sources, source)
+}
+
+// Touch records activity on the cluster
+func (m *Monitor) Touch() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.touchUnlocked()
+}
+
+func (m *Monitor) touchUnlocked() {
+	m.lastActivity = m.now()
+	m.warning = ""
+}
+
+func (m *Monitor) Status() Status {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	timeout := m.timeout()
+	if timeout <= 0 {
+		return Status{}
+	}
+	return Status{
+		Enabled:   true,
+		IdleSince: m.lastActivity,
+		StopTime:  m.lastActivity.Add(timeout),
+		Warning:   m.warning,
+	}
+}
+
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.check()
+		}
+	}
+}
+
+func (m *Monitor) check() {
+	m.lock.Lock()
+	sources := m.sources
+	m.lock.Unlock()
+
+	// sources are always polled so that they don't accumulate activity
+	// while the auto-stop is disabled or the cluster is stopped
+	active := false
+	for _, source := range sources {
+		if source() {
+			active = true
+		}
+	}
+	timeout := m.timeout()
+	running, err := m.isRunning()
+	if err != nil {
+		logging.Debugf("Cannot determine if the cluster is running: %v", err)
+	}
+	if active || timeout <= 0 || !running {
+		m.Touch()
+		return
+	}
+
+	m.lock.Lock()
+	idle := m.now().Sub(m.lastActivity)
+	if idle < timeout {
+		if idle >= timeout-warningPeriod && m.warning == "" {
+			m.warning = fmt.Sprintf("The OpenShift cluster has been idle for %s and will be stopped in %s",
+				units.HumanDuration(idle), units.HumanDuration(timeout-idle))
+			logging.Warn(m.warning)
+		}
+		m.lock.Unlock()
+		return
+	}
+	m.touchUnlocked()
+	m.lock.Unlock()
+
+	logging.Infof("Stopping the OpenShift cluster after %s of inactivity", units.HumanDuration(idle))
+	if err := m.stop(); err != nil {
+		logging.Errorf("Cannot stop the OpenShift cluster: %v", err)
+	}
+}
+
+// NetworkActivity returns an activity source reporting activity when more
+// than threshold bytes were transferred since its previous call. An idle
+// cluster still has some background traffic, hence the threshold.
+func NetworkActivity(transferredBytes func() uint64, threshold uint64) func() bool {
+	previous := transferredBytes()
+	return func() bool {
+		current := transferredBytes()
+		active := current-previous >= threshold
+		previous = current
+		return active
+	}
+}
+
+// OwnTraffic excludes the bytes transferred while the daemon talks to the VM,
+// its status polls for instance, from the transferred bytes. The periods during
+// which the daemon talks to the VM may overlap.
+type OwnTraffic struct {
+	transferredBytes func() uint64
+
+	lock     sync.Mutex
+	running  int
+	start    uint64
+	excluded uint64
+}
+
+func NewOwnTraffic(transferredBytes func() uint64) *OwnTraffic {
+	return &OwnTraffic{
+		transferredBytes: transferredBytes,
+	}
+}
+
+// Track runs fun, the bytes transferred meanwhile are not counted
+func (o *OwnTraffic) Track(fun func()) {
+	o.lock.Lock()
+	if o.running == 0 {
+		o.start = o.transferredBytes()
+	}
+	o.running++
+	o.lock.Unlock()
+
+	defer func() {
+		o.lock.Lock()
+		defer o.lock.Unlock()
+		o.running--
+		if o.running == 0 {
+			o.excluded += o.transferredBytes() - o.start
+		}
+	}()
+	fun()
+}
+
+// TransferredBytes returns the transferred bytes, without the ones transferred
+// by the tracked functions
+func (o *OwnTraffic) TransferredBytes() uint64 {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	transferred := o.transferredBytes()
+	excluded := o.excluded
+	if o.running > 0 {
+		excluded += transferred - o.start
+	}
+	return transferred - excluded
+}
+
+// vmActivityCommand prints the number of login sessions in the VM, then the
+// bytes received and sent by its network devices, the virtual ones used by the
+// pods excepted. The SSH connections of crc run commands without terminal, so
+// they are not login sessions.
+const vmActivityCommand = `who | wc -l; ` +
+	`for dev in /sys/class/net/*; do if [ -e $dev/device ]; then cat $dev/statistics/rx_bytes $dev/statistics/tx_bytes; fi; done`
+
+// VMActivity returns an activity source reporting activity when interactive
+// SSH sessions are open in the VM, or when its network devices transferred more
+// than threshold bytes since its previous call. A zero threshold only checks the
+// SSH sessions. run runs a shell command in the VM.
+func VMActivity(run func(command string) (string, error), threshold uint64) func() bool {
+	var previous uint64
+	known := false
+	return func() bool {
+		output, err := run(vmActivityCommand)
+		if err != nil {
+			logging.Debugf("Cannot read the activity of the VM: %v", err)
+			known = false
+			return false
+		}
+		sessions, transferred, err := parseVMActivity(output)
+		if err != nil {
+			logging.Debugf("Cannot read the activity of the VM: %v", err)
+			known = false
+			return false
+		}
+		// the counters are reset when the VM reboots
+		active := sessions > 0 ||
+			(threshold > 0 && known && transferred >= previous && transferred-previous >= threshold)
+		previous = transferred
+		known = true
+		return active
+	}
+}
+
+func parseVMActivity(output string) (int, uint64, error) {
+	fields := strings.Fields(output)
+	if len(fields) == 0 {
+		return 0, 0, fmt.Errorf("unexpected empty output")
+	}
+	sessions, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	var transferred uint64
+	for _, field := range fields[1:] {
+		bytes, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+		transferred += bytes
+	}
+	return sessions, transferred, nil
+}
@@ -0,0 +1,144 @@
+package autostop
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCluster struct {
+	running bool
+	stops   int
+	clock   time.Time
+}
+
+func newTestMonitor(cluster *fakeCluster, timeout time.Duration) *Monitor {
+	monitor := NewMonitor(func() time.Duration {
+		return timeout
+	}, func() (bool, error) {
+		return cluster.running, nil
+	}, func() error {
+		cluster.stops++
+		cluster.running = false
+		return nil
+	})
+	monitor.now = func() time.Time {
+		return cluster.clock
+	}
+	monitor.Touch()
+	return monitor
+}
+
+func TestMonitorStopsIdleCluster(t *testing.T) {
+	cluster := &fakeCluster{running: true, clock: time.Date(2021, time.June, 1, 10, 0, 0, 0, time.UTC)}
+	monitor := newTestMonitor(cluster, time.Hour)
+
+	cluster.clock = cluster.clock.Add(50 * time.Minute)
+	monitor.check()
+	assert.Equal(t, 0, cluster.stops)
+	assert.Empty(t, monitor.Status().Warning)
+
+	cluster.clock = cluster.clock.Add(6 * time.Minute)
+	monitor.check()
+	assert.Equal(t, 0, cluster.stops)
+	assert.Equal(t, "The OpenShift cluster has been idle for 56 minutes and will be stopped in 4 minutes", monitor.Status().Warning)
+	assert.Equal(t, time.Date(2021, time.June, 1, 11, 0, 0, 0, time.UTC), monitor.Status().StopTime)
+
+	cluster.clock = cluster.clock.Add(4 * time.Minute)
+	monitor.check()
+	assert.Equal(t, 1, cluster.stops)
+	assert.Empty(t, monitor.Status().Warning)
+
+	cluster.clock = cluster.clock.Add(2 * time.Hour)
+	monitor.check()
+	assert.Equal(t, 1, cluster.stops)
+}
+
+func TestMonitorActivity(t *testing.T) {
+	cluster := &fakeCluster{running: true, clock: time.Date(2021, time.June, 1, 10, 0, 0, 0, time.UTC)}
+	monitor := newTestMonitor(cluster, time.Hour)
+
+	var transferred uint64
+	monitor.AddActivitySource(NetworkActivity(func() uint64 {
+		return transferred
+	}, 1000))
+
+	cluster.clock = cluster.clock.Add(56 * time.Minute)
+	transferred += 100
+	monitor.check()
+	assert.NotEmpty(t, monitor.Status().Warning)
+
+	cluster.clock = cluster.clock.Add(time.Minute)
+	transferred += 5000
+	monitor.check()
+	assert.Empty(t, monitor.Status().Warning)
+
+	cluster.clock = cluster.clock.Add(30 * time.Minute)
+	monitor.Touch()
+	cluster.clock = cluster.clock.Add(59 * time.Minute)
+	monitor.check()
+	assert.Equal(t, 0, cluster.stops)
+}
+
+func TestMonitorDisabled(t *testing.T) {
+	cluster := &fakeCluster{running: true, clock: time.Date(2021, time.June, 1, 10, 0, 0, 0, time.UTC)}
+	monitor := newTestMonitor(cluster, 0)
+
+	cluster.clock = cluster.clock.Add(24 * time.Hour)
+	monitor.check()
+	assert.Equal(t, 0, cluster.stops)
+	assert.Equal(t, Status{}, monitor.Status())
+}
+
+func TestVMActivity(t *testing.T) {
+	var output string
+	var err error
+	source := VMActivity(func(command string) (string, error) {
+		assert.Equal(t, vmActivityCommand, command)
+		return output, err
+	}, 1000)
+
+	output = "0\n5000\n2000\n"
+	assert.False(t, source())
+	output = "0\n5400\n2500\n"
+	assert.False(t, source())
+	output = "0\n6000\n3000\n"
+	assert.True(t, source())
+	output = "1\n6000\n3000\n"
+	assert.True(t, source())
+
+	// the counters are reset when the VM reboots
+	output = "0\n100\n100\n"
+	assert.False(t, source())
+
+	err = errors.New("the VM is not running")
+	assert.False(t, source())
+	err = nil
+	output = "0\n9000\n9000\n"
+	assert.False(t, source())
+	output = "unexpected"
+	assert.False(t, source())
+}
+
+func TestOwnTraffic(t *testing.T) {
+	var transferred uint64 = 1000
+	ownTraffic := NewOwnTraffic(func() uint64 {
+		return transferred
+	})
+	assert.Equal(t, uint64(1000), ownTraffic.TransferredBytes())
+
+	ownTraffic.Track(func() {
+		transferred += 200
+		ownTraffic.Track(func() {
+			transferred += 300
+			assert.Equal(t, uint64(1000), ownTraffic.TransferredBytes())
+		})
+		transferred += 100
+	})
+	assert.Equal(t, uint64(1000), ownTraffic.TransferredBytes())
+
+	transferred += 50
+	assert.Equal(t, uint64(1050), ownTraffic.TransferredBytes())
+}
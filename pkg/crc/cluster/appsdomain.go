@@ -0,0 +1,273 @@
+package cluster
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/oc"
+	"github.com/code-ready/crc/pkg/crc/ssh"
+	crctls "github.com/code-ready/crc/pkg/crc/tls"
+)
+
+const (
+	ingressCertSecretName     = "crc-ingress-cert"
+	ingressCertSecretFileName = "/tmp/crc-ingress-cert.json"
+
+	// records the default certificate of the router before it was replaced
+	previousDefaultCertAnnotation = "crc.dev/previous-default-certificate"
+
+	// browsers reject the server certificates valid for more than 825 days
+	ingressCertValidity = 2 * crctls.ValidityOneYear
+)
+
+// componentRoutes are the routes of the cluster components moved to the apps domain
+var componentRoutes = []struct {
+	name      string
+	namespace string
+	host      string
+}{
+	{"console", "openshift-console", "console-openshift-console"},
+	{"downloads", "openshift-console", "downloads-openshift-console"},
+	{"oauth-openshift", "openshift-authentication", "oauth-openshift"},
+}
+
+// EnsureAppsDomain makes the routes of the console and of the OAuth server, and
+// the routes created without a host, use appsDomain. The default certificate of
+// the router is replaced with a wildcard certificate for appsDomain, signed by a
+// CA written to caFile.
+func EnsureAppsDomain(ocConfig oc.Config, sshRunner *ssh.Runner, appsDomain, caFile string) error {
+	if err := WaitForOpenshiftResource(ocConfig, "ingresscontroller"); err != nil {
+		return err
+	}
+	currentCert, err := defaultIngressCertificate(ocConfig)
+	if err != nil {
+		return err
+	}
+
+	if !ingressCertIsValid(ocConfig, appsDomain, caFile) {
+		previousCert := currentCert
+		if currentCert == ingressCertSecretName {
+			if previousCert, err = previousDefaultIngressCertificate(ocConfig); err != nil {
+				return err
+			}
+		}
+		logging.Infof("Generating a certificate for the routes of %s...", appsDomain)
+		if err := createIngressCertSecret(ocConfig, sshRunner, appsDomain, caFile, previousCert); err != nil {
+			return err
+		}
+	}
+
+	if currentCert != ingressCertSecretName {
+		logging.Info("Replacing the default certificate of the router...")
+		if err := patchDefaultIngressCertificate(ocConfig, ingressCertSecretName); err != nil {
+			return err
+		}
+	}
+
+	var routes []map[string]string
+	for _, route := range componentRoutes {
+		routes = append(routes, map[string]string{
+			"name":      route.name,
+			"namespace": route.namespace,
+			"hostname":  fmt.Sprintf("%s.%s", route.host, appsDomain),
+		})
+	}
+	return patchIngressConfig(ocConfig, map[string]interface{}{
+		"appsDomain":      appsDomain,
+		"componentRoutes": routes,
+	})
+}
+
+// ResetAppsDomain undoes EnsureAppsDomain, the routes of the cluster components
+// go back to the domain the cluster was installed with
+func ResetAppsDomain(ocConfig oc.Config) error {
+	if err := WaitForOpenshiftResource(ocConfig, "ingresscontroller"); err != nil {
+		return err
+	}
+	currentCert, err := defaultIngressCertificate(ocConfig)
+	if err != nil {
+		return err
+	}
+	appsDomain, stderr, err := ocConfig.RunOcCommand("get", "ingresses.config.openshift.io", "cluster", "-o", `jsonpath='{.spec.appsDomain}'`)
+	if err != nil {
+		return fmt.Errorf("Failed to get the ingress configuration %v: %s", err, stderr)
+	}
+	if currentCert != ingressCertSecretName && strings.TrimSpace(appsDomain) == "" {
+		return nil
+	}
+
+	logging.Info("Moving the routes back to the default apps domain...")
+	if currentCert == ingressCertSecretName {
+		previousCert, err := previousDefaultIngressCertificate(ocConfig)
+		if err != nil {
+			return err
+		}
+		if err := patchDefaultIngressCertificate(ocConfig, previousCert); err != nil {
+			return err
+		}
+	}
+	if err := patchIngressConfig(ocConfig, map[string]interface{}{
+		"appsDomain":      nil,
+		"componentRoutes": nil,
+	}); err != nil {
+		return err
+	}
+	if _, stderr, err := ocConfig.RunOcCommand("delete", "secret", ingressCertSecretName, "-n", "openshift-ingress", "--ignore-not-found"); err != nil {
+		return fmt.Errorf("Failed to delete the %s secret %v: %s", ingressCertSecretName, err, stderr)
+	}
+	return nil
+}
+
+func defaultIngressCertificate(ocConfig oc.Config) (string, error) {
+	stdout, stderr, err := ocConfig.RunOcCommand("get", "ingresscontroller", "default", "-n", "openshift-ingress-operator",
+		"-o", `jsonpath='{.spec.defaultCertificate.name}'`)
+	if err != nil {
+		return "", fmt.Errorf("Failed to get the default ingress controller %v: %s", err, stderr)
+	}
+	return strings.TrimSpace(stdout), nil
+}
+
+func previousDefaultIngressCertificate(ocConfig oc.Config) (string, error) {
+	stdout, stderr, err := ocConfig.RunOcCommand("get", "secret", ingressCertSecretName, "-n", "openshift-ingress",
+		"-o", fmt.Sprintf(`jsonpath='{.metadata.annotations.%s}'`, strings.ReplaceAll(previousDefaultCertAnnotation, ".", `\.`)))
+	if err != nil {
+		return "", fmt.Errorf("Failed to get the %s secret %v: %s", ingressCertSecretName, err, stderr)
+	}
+	return strings.TrimSpace(stdout), nil
+}
+
+func patchDefaultIngressCertificate(ocConfig oc.Config, name string) error {
+	var defaultCertificate interface{}
+	if name != "" {
+		defaultCertificate = map[string]string{"name": name}
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"defaultCertificate": defaultCertificate},
+	})
+	if err != nil {
+		return err
+	}
+	_, stderr, err := ocConfig.RunOcCommand("patch", "ingresscontroller", "default", "-n", "openshift-ingress-operator",
+		"--type", "merge", "-p", fmt.Sprintf("'%s'", patch))
+	if err != nil {
+		return fmt.Errorf("Failed to update the default certificate of the router %v: %s", err, stderr)
+	}
+	return nil
+}
+
+func patchIngressConfig(ocConfig oc.Config, spec map[string]interface{}) error {
+	patch, err := json.Marshal(map[string]interface{}{"spec": spec})
+	if err != nil {
+		return err
+	}
+	_, stderr, err := ocConfig.RunOcCommand("patch", "ingresses.config.openshift.io", "cluster",
+		"--type", "merge", "-p", fmt.Sprintf("'%s'", patch))
+	if err != nil {
+		return fmt.Errorf("Failed to update the ingress configuration %v: %s", err, stderr)
+	}
+	return nil
+}
+
+// ingressCertIsValid checks if the certificate of the secret is valid for the
+// routes of appsDomain and signed by the CA of caFile
+func ingressCertIsValid(ocConfig oc.Config, appsDomain, caFile string) bool {
+	ca, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return false
+	}
+	stdout, _, err := ocConfig.RunOcCommand("get", "secret", ingressCertSecretName, "-n", "openshift-ingress",
+		"-o", `jsonpath='{.data.tls\.crt}'`)
+	if err != nil {
+		return false
+	}
+	certPem, err := base64.StdEncoding.DecodeString(strings.TrimSpace(stdout))
+	if err != nil {
+		return false
+	}
+	return verifyIngressCert(ca, certPem, appsDomain) == nil
+}
+
+func verifyIngressCert(caPem, certPem []byte, appsDomain string) error {
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caPem) {
+		return fmt.Errorf("failed to parse the ingress CA")
+	}
+	block, _ := pem.Decode(certPem)
+	if block == nil {
+		return fmt.Errorf("failed to decode the ingress certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+	_, err = cert.Verify(x509.VerifyOptions{
+		DNSName: fmt.Sprintf("console-openshift-console.%s", appsDomain),
+		Roots:   roots,
+	})
+	return err
+}
+
+func generateIngressCert(appsDomain string) ([]byte, []byte, []byte, error) {
+	caKey, caCert, err := crctls.GenerateSelfSignedCertificate(&crctls.CertCfg{
+		Subject:   pkix.Name{CommonName: "crc-ingress-signer", OrganizationalUnit: []string{"crc"}},
+		KeyUsages: x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		Validity:  crctls.ValidityTenYears,
+		IsCA:      true,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	key, cert, err := crctls.GenerateSignedCertificate(caKey, caCert, &crctls.CertCfg{
+		Subject:      pkix.Name{CommonName: fmt.Sprintf("*.%s", appsDomain), OrganizationalUnit: []string{"crc"}},
+		DNSNames:     []string{fmt.Sprintf("*.%s", appsDomain), appsDomain},
+		KeyUsages:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		Validity:     ingressCertValidity,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return crctls.CertToPem(caCert), crctls.CertToPem(cert), crctls.PrivateKeyToPem(key), nil
+}
+
+func createIngressCertSecret(ocConfig oc.Config, sshRunner *ssh.Runner, appsDomain, caFile, previousCert string) error {
+	caPem, certPem, keyPem, err := generateIngressCert(appsDomain)
+	if err != nil {
+		return err
+	}
+	// the router serves the chain, the clients only need to trust the CA
+	secret, err := json.Marshal(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"type":       "kubernetes.io/tls",
+		"metadata": map[string]interface{}{
+			"name":        ingressCertSecretName,
+			"namespace":   "openshift-ingress",
+			"annotations": map[string]string{previousDefaultCertAnnotation: previousCert},
+		},
+		"data": map[string][]byte{
+			"tls.crt": append(certPem, caPem...),
+			"tls.key": keyPem,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if err := sshRunner.CopyData(secret, ingressCertSecretFileName, 0600); err != nil {
+		return err
+	}
+	defer func() {
+		_, _, _ = sshRunner.Run("rm", "-f", ingressCertSecretFileName)
+	}()
+	if _, stderr, err := ocConfig.RunOcCommandPrivate("apply", "-f", ingressCertSecretFileName); err != nil {
+		return fmt.Errorf("Failed to create the %s secret %v: %s", ingressCertSecretName, err, stderr)
+	}
+	return ioutil.WriteFile(caFile, caPem, 0600)
+}
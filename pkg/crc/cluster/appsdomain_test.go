@@ -0,0 +1,21 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIngressCert(t *testing.T) {
+	caPem, certPem, keyPem, err := generateIngressCert("apps.example.lan")
+	require.NoError(t, err)
+	assert.Contains(t, string(keyPem), "PRIVATE KEY")
+
+	assert.NoError(t, verifyIngressCert(caPem, certPem, "apps.example.lan"))
+	assert.Error(t, verifyIngressCert(caPem, certPem, "apps-crc.testing"))
+
+	otherCAPem, _, _, err := generateIngressCert("apps.example.lan")
+	require.NoError(t, err)
+	assert.Error(t, verifyIngressCert(otherCAPem, certPem, "apps.example.lan"))
+}
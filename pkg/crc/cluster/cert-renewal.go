@@ -29,7 +29,7 @@ func ApproveCSRAndWaitForCertsRenewal(sshRunner *ssh.Runner, ocConfig oc.Config,
 	// Admin needs to approve it. The Kubernetes controller manager will then issue the cert, kubelet will fetch it and use it.
 	// Kubelet stores the cert in /var/lib/kubelet/pki/kubelet-client-current.pem
 	if client {
-		logging.Info("Kubelet client certificate has expired, renewing it... [will take up to 8 minutes]")
+		logging.Info("Renewing the kubelet client certificate... [will take up to 8 minutes]")
 		if err := waitForPendingCSRs(ocConfig, kubeletClientSignerName); err != nil {
 			logging.Debugf("Error waiting for pending kube-apiserver-client-kubelet CSR: %v", err)
 			return err
@@ -48,7 +48,7 @@ func ApproveCSRAndWaitForCertsRenewal(sshRunner *ssh.Runner, ocConfig oc.Config,
 	// After kubelet connected to the API server, if the serving cert is expireed, kubelet asks for a new CSR.
 	// This CSR is automatically approved by the cluster-machine-approver. The k8s controller manager issues the cert and kubelet fetches it.
 	if server {
-		logging.Info("Kubelet serving certificate has expired, waiting for automatic renewal... [will take up to 8 minutes]")
+		logging.Info("Waiting for the automatic renewal of the kubelet serving certificate... [will take up to 8 minutes]")
 		return crcerrors.RetryAfter(5*time.Minute, waitForCertRenewal(sshRunner, KubeletServerCert), time.Second*5)
 	}
 	return nil
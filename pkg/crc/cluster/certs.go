@@ -0,0 +1,102 @@
+package cluster
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/oc"
+	"github.com/code-ready/crc/pkg/crc/ssh"
+)
+
+const (
+	apiServerServingCert = "/etc/kubernetes/static-pod-resources/kube-apiserver-certs/secrets/external-loadbalancer-serving-certkey/tls.crt"
+	clientCABundle       = "/etc/kubernetes/static-pod-resources/kube-apiserver-certs/configmaps/client-ca/ca-bundle.crt"
+)
+
+// CertExpiry is the expiry date of one of the certificates of the cluster.
+// Error is set when the certificate could not be read.
+type CertExpiry struct {
+	Name     string    `json:"name"`
+	NotAfter time.Time `json:"notAfter"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// GetCertsExpiry returns the expiry date of the kubelet, API server, ingress and
+// client CA certificates. A failure to read one certificate does not prevent
+// reading the others, it is reported in the Error field of its entry.
+func GetCertsExpiry(sshRunner *ssh.Runner, ocConfig oc.Config) []CertExpiry {
+	files := []struct {
+		name string
+		path string
+	}{
+		{"kubelet client", KubeletClientCert},
+		{"kubelet server", KubeletServerCert},
+		{"API server", apiServerServingCert},
+		{"client CA", clientCABundle},
+		{"aggregator client CA", AggregatorClientCert},
+	}
+	var certs []CertExpiry
+	for _, file := range files {
+		certs = append(certs, newCertExpiry(file.name, func() ([]byte, error) {
+			output, _, err := sshRunner.RunPrivate("sudo", "cat", file.path)
+			return []byte(output), err
+		}))
+	}
+	certs = append(certs, newCertExpiry("ingress CA", func() ([]byte, error) {
+		return ingressCA(ocConfig)
+	}))
+	return certs
+}
+
+func newCertExpiry(name string, read func() ([]byte, error)) CertExpiry {
+	data, err := read()
+	if err != nil {
+		return CertExpiry{Name: name, Error: err.Error()}
+	}
+	notAfter, err := earliestExpiry(data)
+	if err != nil {
+		return CertExpiry{Name: name, Error: err.Error()}
+	}
+	return CertExpiry{Name: name, NotAfter: notAfter}
+}
+
+func ingressCA(ocConfig oc.Config) ([]byte, error) {
+	output, _, err := ocConfig.RunOcCommandPrivate("get", "secret", "router-ca", "-n", "openshift-ingress-operator",
+		"-o", `jsonpath='{.data.tls\.crt}'`)
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(output))
+}
+
+// earliestExpiry returns the earliest expiry date of the PEM encoded
+// certificates in data. CA bundles can contain several certificates.
+func earliestExpiry(data []byte) (time.Time, error) {
+	var notAfter time.Time
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("Failed to parse certificate: %v", err)
+		}
+		if notAfter.IsZero() || cert.NotAfter.Before(notAfter) {
+			notAfter = cert.NotAfter
+		}
+	}
+	if notAfter.IsZero() {
+		return time.Time{}, errors.New("no certificate found")
+	}
+	return notAfter, nil
+}
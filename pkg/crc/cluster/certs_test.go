@@ -0,0 +1,40 @@
+package cluster
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	crctls "github.com/code-ready/crc/pkg/crc/tls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func selfSignedCert(t *testing.T, validity time.Duration) *x509.Certificate {
+	_, cert, err := crctls.GenerateSelfSignedCertificate(&crctls.CertCfg{
+		Subject:   pkix.Name{CommonName: "test", OrganizationalUnit: []string{"crc"}},
+		KeyUsages: x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		Validity:  validity,
+		IsCA:      true,
+	})
+	require.NoError(t, err)
+	return cert
+}
+
+func TestEarliestExpiry(t *testing.T) {
+	oneYear := selfSignedCert(t, crctls.ValidityOneYear)
+	oneDay := selfSignedCert(t, crctls.ValidityOneDay)
+
+	notAfter, err := earliestExpiry(crctls.CertToPem(oneYear))
+	assert.NoError(t, err)
+	assert.True(t, oneYear.NotAfter.Equal(notAfter))
+
+	bundle := append(crctls.CertToPem(oneYear), crctls.CertToPem(oneDay)...)
+	notAfter, err = earliestExpiry(bundle)
+	assert.NoError(t, err)
+	assert.True(t, oneDay.NotAfter.Equal(notAfter))
+
+	_, err = earliestExpiry([]byte("cat: /var/lib/kubelet/pki/kubelet-client-current.pem: No such file or directory"))
+	assert.EqualError(t, err, "no certificate found")
+}
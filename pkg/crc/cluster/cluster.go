@@ -12,7 +12,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/code-ready/crc/pkg/crc/constants"
 	"github.com/code-ready/crc/pkg/crc/errors"
 	"github.com/code-ready/crc/pkg/crc/logging"
 	"github.com/code-ready/crc/pkg/crc/network"
@@ -145,7 +144,7 @@ func EnsurePullSecretPresentInTheCluster(ocConfig oc.Config, pullSec PullSecretL
 	return nil
 }
 
-func EnsureGeneratedClientCAPresentInTheCluster(ocConfig oc.Config, sshRunner *ssh.Runner, selfSignedCACert *x509.Certificate, adminCert string) error {
+func EnsureGeneratedClientCAPresentInTheCluster(ocConfig oc.Config, sshRunner *ssh.Runner, selfSignedCACert *x509.Certificate, adminCert, kubeconfigFilePath string) error {
 	selfSignedCAPem := crctls.CertToPem(selfSignedCACert)
 	if err := WaitForOpenshiftResource(ocConfig, "configmaps"); err != nil {
 		return err
@@ -171,7 +170,7 @@ func EnsureGeneratedClientCAPresentInTheCluster(ocConfig oc.Config, sshRunner *s
 	if err != nil {
 		return fmt.Errorf("Failed to patch admin-kubeconfig-client-ca config map with new CA` %v: %s", err, stderr)
 	}
-	if err := sshRunner.CopyFile(constants.KubeconfigFilePath, ocConfig.KubeconfigPath, 0644); err != nil {
+	if err := sshRunner.CopyFile(kubeconfigFilePath, ocConfig.KubeconfigPath, 0644); err != nil {
 		return fmt.Errorf("Failed to copy generated kubeconfig file to VM: %v", err)
 	}
 
@@ -284,8 +283,8 @@ func AddProxyConfigToCluster(sshRunner *ssh.Runner, ocConfig oc.Config, proxy *n
 
 	patch := &patchSpec{
 		Spec: proxySpecConfig{
-			HTTPProxy:  proxy.HTTPProxy,
-			HTTPSProxy: proxy.HTTPSProxy,
+			HTTPProxy:  proxy.HTTPProxyForDisplay(),
+			HTTPSProxy: proxy.HTTPSProxyForDisplay(),
 			NoProxy:    proxy.GetNoProxyString(),
 		},
 	}
@@ -309,6 +308,13 @@ func AddProxyConfigToCluster(sshRunner *ssh.Runner, ocConfig oc.Config, proxy *n
 	}
 	logging.Debugf("Patch string %s", string(patchEncode))
 
+	// the credentials are only in the patch applied to the cluster
+	patch.Spec.HTTPProxy = proxy.HTTPProxyWithCredentials()
+	patch.Spec.HTTPSProxy = proxy.HTTPSProxyWithCredentials()
+	if patchEncode, err = json.Marshal(patch); err != nil {
+		return fmt.Errorf("Failed to encode to json: %v", err)
+	}
+
 	cmdArgs := []string{"patch", "proxy", "cluster", "-p", fmt.Sprintf("'%s'", string(patchEncode)), "-n", "openshift-config", "--type", "merge"}
 	if _, stderr, err := ocConfig.RunOcCommandPrivate(cmdArgs...); err != nil {
 		return fmt.Errorf("Failed to add proxy details %v: %s", err, stderr)
@@ -354,13 +360,14 @@ func AddProxyToKubeletAndCriO(sshRunner *ssh.Runner, proxy *network.ProxyConfig)
 Environment=HTTP_PROXY=%s
 Environment=HTTPS_PROXY=%s
 Environment=NO_PROXY=.cluster.local,.svc,10.217.0.0/22,10.217.4.0/23,192.168.126.0/24,%s`
-	p := fmt.Sprintf(proxyTemplate, proxy.HTTPProxy, proxy.HTTPSProxy, proxy.GetNoProxyString())
+	p := fmt.Sprintf(proxyTemplate, proxy.HTTPProxyWithCredentials(), proxy.HTTPSProxyWithCredentials(), proxy.GetNoProxyString())
 	// This will create a systemd drop-in configuration for proxy (both for kubelet and crio services) on the VM.
-	err := sshRunner.CopyData([]byte(p), "/etc/systemd/system/crio.service.d/10-default-env.conf", 0644)
+	// Only root can read it since the proxy URLs may contain credentials.
+	err := sshRunner.CopyData([]byte(p), "/etc/systemd/system/crio.service.d/10-default-env.conf", 0600)
 	if err != nil {
 		return err
 	}
-	err = sshRunner.CopyData([]byte(p), "/etc/systemd/system/kubelet.service.d/10-default-env.conf", 0644)
+	err = sshRunner.CopyData([]byte(p), "/etc/systemd/system/kubelet.service.d/10-default-env.conf", 0600)
 	if err != nil {
 		return err
 	}
@@ -465,7 +472,7 @@ func CheckProxySettingsForOperator(ocConfig oc.Config, proxy *network.ProxyConfi
 	if err != nil {
 		return false, err
 	}
-	if strings.Contains(out, proxy.HTTPSProxy) || strings.Contains(out, proxy.HTTPProxy) {
+	if strings.Contains(out, proxy.HTTPSProxyWithCredentials()) || strings.Contains(out, proxy.HTTPProxyWithCredentials()) {
 		return true, nil
 	}
 	return false, nil
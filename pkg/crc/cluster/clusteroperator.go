@@ -32,6 +32,18 @@ type Status struct {
 	unavailable []string
 }
 
+// OperatorStatus is the state of a single cluster operator, the message and
+// the transition time come from the condition which explains this state
+type OperatorStatus struct {
+	Name               string    `json:"name"`
+	Available          bool      `json:"available"`
+	Progressing        bool      `json:"progressing"`
+	Degraded           bool      `json:"degraded"`
+	Disabled           bool      `json:"disabled"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+}
+
 const maxNames = 5
 
 func (status *Status) String() string {
@@ -75,6 +87,11 @@ func min(a, b int) int {
 	return a
 }
 
+// DegradedOperators returns the names of the degraded cluster operators
+func (status *Status) DegradedOperators() []string {
+	return status.degraded
+}
+
 func (status *Status) IsReady() bool {
 	return status.Available && !status.Progressing && !status.Degraded && !status.Disabled
 }
@@ -87,15 +104,36 @@ func GetClusterOperatorsStatus(ctx context.Context, ip string, kubeconfigFilePat
 	return getStatus(ctx, lister.ConfigV1().ClusterOperators(), []string{})
 }
 
-func getStatus(ctx context.Context, lister operatorLister, selector []string) (*Status, error) {
-	cs := &Status{
-		Available: true,
+// GetClusterOperators returns the overall status of the cluster operators and
+// the status of each of them
+func GetClusterOperators(ctx context.Context, ip string, kubeconfigFilePath string) (*Status, []OperatorStatus, error) {
+	lister, err := kubernetesClient(ip, kubeconfigFilePath)
+	if err != nil {
+		return nil, nil, err
 	}
+	co, err := lister.ConfigV1().ClusterOperators().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	status, err := statusFromList(co, []string{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return status, operatorsFromList(co), nil
+}
 
+func getStatus(ctx context.Context, lister operatorLister, selector []string) (*Status, error) {
 	co, err := lister.List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
+	return statusFromList(co, selector)
+}
+
+func statusFromList(co *openshiftapi.ClusterOperatorList, selector []string) (*Status, error) {
+	cs := &Status{
+		Available: true,
+	}
 
 	found := false
 	for _, c := range co.Items {
@@ -143,6 +181,48 @@ func getStatus(ctx context.Context, lister operatorLister, selector []string) (*
 	return cs, nil
 }
 
+func operatorsFromList(co *openshiftapi.ClusterOperatorList) []OperatorStatus {
+	var operators []OperatorStatus
+	for _, c := range co.Items {
+		operator := OperatorStatus{
+			Name: c.ObjectMeta.Name,
+		}
+		var available, progressing, degraded *openshiftapi.ClusterOperatorStatusCondition
+		for i := range c.Status.Conditions {
+			con := &c.Status.Conditions[i]
+			switch con.Type {
+			case openshiftapi.OperatorAvailable:
+				operator.Available = con.Status == openshiftapi.ConditionTrue
+				available = con
+			case openshiftapi.OperatorProgressing:
+				operator.Progressing = con.Status == openshiftapi.ConditionTrue
+				progressing = con
+			case openshiftapi.OperatorDegraded:
+				operator.Degraded = con.Status == openshiftapi.ConditionTrue
+				degraded = con
+			case "Disabled":
+				operator.Disabled = con.Status == openshiftapi.ConditionTrue
+			}
+		}
+
+		var reason *openshiftapi.ClusterOperatorStatusCondition
+		switch {
+		case operator.Degraded:
+			reason = degraded
+		case operator.Progressing:
+			reason = progressing
+		default:
+			reason = available
+		}
+		if reason != nil {
+			operator.Message = reason.Message
+			operator.LastTransitionTime = reason.LastTransitionTime.Time
+		}
+		operators = append(operators, operator)
+	}
+	return operators
+}
+
 func contains(value string, list []string) bool {
 	for _, v := range list {
 		if v == value {
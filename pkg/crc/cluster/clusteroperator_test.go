@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"path/filepath"
 	"testing"
+	"time"
 
 	v1 "github.com/openshift/api/config/v1"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +36,30 @@ func TestGetClusterOperatorsStatusProgressing(t *testing.T) {
 	assert.Equal(t, progressing, status)
 }
 
+func TestOperatorsFromList(t *testing.T) {
+	co, err := lister("co-progressing.json").List(context.Background(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []OperatorStatus{
+		{
+			Name:               "authentication",
+			Available:          true,
+			Progressing:        true,
+			LastTransitionTime: time.Date(2020, 6, 21, 10, 40, 40, 0, time.UTC).Local(),
+		},
+		{
+			Name:               "cloud-credential",
+			Available:          true,
+			LastTransitionTime: time.Date(2020, 6, 20, 6, 58, 30, 0, time.UTC).Local(),
+		},
+		{
+			Name:               "cluster-autoscaler",
+			Available:          true,
+			Message:            "at version 4.4.8",
+			LastTransitionTime: time.Date(2020, 6, 20, 7, 26, 9, 0, time.UTC).Local(),
+		},
+	}, operatorsFromList(co))
+}
+
 type mockLister struct {
 	file string
 }
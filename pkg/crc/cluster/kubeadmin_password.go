@@ -16,10 +16,10 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
-// GenerateKubeAdminUserPassword creates and put updated kubeadmin password to ~/.crc/machine/crc/kubeadmin-password
-func GenerateKubeAdminUserPassword() error {
+// GenerateKubeAdminUserPassword creates and put updated kubeadmin password to ~/.crc/machine/<name>/kubeadmin-password
+func GenerateKubeAdminUserPassword(name string) error {
 	logging.Infof("Generating new password for the kubeadmin user")
-	kubeAdminPasswordFile := constants.GetKubeAdminPasswordPath()
+	kubeAdminPasswordFile := constants.GetKubeAdminPasswordPath(name)
 	kubeAdminPassword, err := GenerateRandomPasswordHash(23)
 	if err != nil {
 		return fmt.Errorf("Cannot generate the kubeadmin user password: %w", err)
@@ -28,15 +28,15 @@ func GenerateKubeAdminUserPassword() error {
 }
 
 // UpdateKubeAdminUserPassword updates the htpasswd secret
-func UpdateKubeAdminUserPassword(ocConfig oc.Config, newPassword string) error {
+func UpdateKubeAdminUserPassword(name string, ocConfig oc.Config, newPassword string) error {
 	if newPassword != "" {
 		logging.Infof("Overriding password for kubeadmin user")
-		if err := ioutil.WriteFile(constants.GetKubeAdminPasswordPath(), []byte(strings.TrimSpace(newPassword)), 0600); err != nil {
+		if err := ioutil.WriteFile(constants.GetKubeAdminPasswordPath(name), []byte(strings.TrimSpace(newPassword)), 0600); err != nil {
 			return err
 		}
 	}
 
-	kubeAdminPassword, err := GetKubeadminPassword()
+	kubeAdminPassword, err := GetKubeadminPassword(name)
 	if err != nil {
 		return fmt.Errorf("Cannot generate the kubeadmin user password: %w", err)
 	}
@@ -72,8 +72,8 @@ func UpdateKubeAdminUserPassword(ocConfig oc.Config, newPassword string) error {
 	return nil
 }
 
-func GetKubeadminPassword() (string, error) {
-	kubeAdminPasswordFile := constants.GetKubeAdminPasswordPath()
+func GetKubeadminPassword(name string) (string, error) {
+	kubeAdminPasswordFile := constants.GetKubeAdminPasswordPath(name)
 	rawData, err := ioutil.ReadFile(kubeAdminPasswordFile)
 	if err != nil {
 		return "", err
@@ -12,18 +12,15 @@ import (
 
 	crcConfig "github.com/code-ready/crc/pkg/crc/config"
 	"github.com/code-ready/crc/pkg/crc/constants"
+	"github.com/code-ready/crc/pkg/crc/keyring"
 	"github.com/code-ready/crc/pkg/crc/logging"
 	"github.com/code-ready/crc/pkg/crc/validation"
 	crcversion "github.com/code-ready/crc/pkg/crc/version"
 	crcos "github.com/code-ready/crc/pkg/os"
-	"github.com/zalando/go-keyring"
 	"gopkg.in/AlecAivazis/survey.v1"
 )
 
-const (
-	keyringService = "crc"
-	keyringUser    = "compressed-pull-secret"
-)
+const keyringUser = "compressed-pull-secret"
 
 type PullSecretLoader interface {
 	Value() (string, error)
@@ -106,7 +103,7 @@ func (loader *nonInteractivePullSecretLoader) Value() (string, error) {
 }
 
 func loadFromKeyring() (string, error) {
-	pullsecret, err := keyring.Get(keyringService, keyringUser)
+	pullsecret, err := keyring.Get(keyringUser)
 	if err != nil {
 		return "", err
 	}
@@ -143,11 +140,11 @@ func StoreInKeyring(pullSecret string) error {
 	if err := compressor.Close(); err != nil {
 		return err
 	}
-	return keyring.Set(keyringService, keyringUser, base64.StdEncoding.EncodeToString(b.Bytes()))
+	return keyring.Set(keyringUser, base64.StdEncoding.EncodeToString(b.Bytes()))
 }
 
 func ForgetPullSecret() error {
-	_ = keyring.Delete(keyringService, keyringUser)
+	_, _ = keyring.Delete(keyringUser)
 	return nil
 }
 
@@ -0,0 +1,100 @@
+package cluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/code-ready/crc/pkg/crc/ssh"
+)
+
+// ResourceUsage is the memory, CPU and workload usage of the VM
+type ResourceUsage struct {
+	RAMSize     int64 // bytes
+	RAMUse      int64
+	LoadAverage []float64 // over 1, 5 and 15 minutes
+	Pods        int       // ready pod sandboxes
+	Containers  int       // running containers
+}
+
+func GetResourceUsage(sshRunner *ssh.Runner) (*ResourceUsage, error) {
+	usage := &ResourceUsage{}
+
+	meminfo, _, err := sshRunner.Run("cat /proc/meminfo")
+	if err != nil {
+		return nil, err
+	}
+	if usage.RAMSize, usage.RAMUse, err = parseMeminfo(meminfo); err != nil {
+		return nil, err
+	}
+
+	loadavg, _, err := sshRunner.Run("cat /proc/loadavg")
+	if err != nil {
+		return nil, err
+	}
+	if usage.LoadAverage, err = parseLoadavg(loadavg); err != nil {
+		return nil, err
+	}
+
+	// the IDs are counted here so that a crictl failure is not reported as 0
+	pods, _, err := sshRunner.Run("sudo crictl pods --state ready --quiet")
+	if err != nil {
+		return nil, err
+	}
+	usage.Pods = countLines(pods)
+
+	containers, _, err := sshRunner.Run("sudo crictl ps --state running --quiet")
+	if err != nil {
+		return nil, err
+	}
+	usage.Containers = countLines(containers)
+	return usage, nil
+}
+
+// parseMeminfo returns the total memory and the memory which is not available
+// to start new applications, in bytes
+func parseMeminfo(meminfo string) (int64, int64, error) {
+	values := map[string]int64{}
+	for _, line := range strings.Split(meminfo, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		value, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		// the values are in kB
+		values[strings.TrimSuffix(fields[0], ":")] = value * 1024
+	}
+	total, ok := values["MemTotal"]
+	if !ok {
+		return 0, 0, fmt.Errorf("MemTotal missing from /proc/meminfo")
+	}
+	available, ok := values["MemAvailable"]
+	if !ok {
+		return 0, 0, fmt.Errorf("MemAvailable missing from /proc/meminfo")
+	}
+	return total, total - available, nil
+}
+
+func parseLoadavg(loadavg string) ([]float64, error) {
+	fields := strings.Fields(loadavg)
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("unexpected /proc/loadavg content: %q", loadavg)
+	}
+	var averages []float64
+	for _, field := range fields[:3] {
+		average, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, err
+		}
+		averages = append(averages, average)
+	}
+	return averages, nil
+}
+
+// countLines returns the number of non-empty lines of the output of a command
+func countLines(output string) int {
+	return len(strings.Fields(output))
+}
@@ -0,0 +1,35 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMeminfo(t *testing.T) {
+	total, used, err := parseMeminfo(`MemTotal:        9396912 kB
+MemFree:          512336 kB
+MemAvailable:    3133624 kB
+Buffers:            2164 kB
+`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9396912*1024), total)
+	assert.Equal(t, int64((9396912-3133624)*1024), used)
+
+	_, _, err = parseMeminfo("MemTotal:        9396912 kB\n")
+	assert.EqualError(t, err, "MemAvailable missing from /proc/meminfo")
+}
+
+func TestParseLoadavg(t *testing.T) {
+	averages, err := parseLoadavg("1.52 0.98 0.61 3/1121 96012\n")
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.52, 0.98, 0.61}, averages)
+
+	_, err = parseLoadavg("")
+	assert.Error(t, err)
+}
+
+func TestCountLines(t *testing.T) {
+	assert.Equal(t, 2, countLines("d5c5bcf8fa3b9\n4e5aa3b2c1f0d\n"))
+	assert.Equal(t, 0, countLines(""))
+}
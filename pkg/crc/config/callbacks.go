@@ -16,6 +16,22 @@ func SuccessfullyApplied(key string, value interface{}) string {
 	return fmt.Sprintf("Successfully configured %s to %s", key, cast.ToString(value))
 }
 
+func RequiresDaemonRestartMsg(key string, _ interface{}) string {
+	return fmt.Sprintf("Changes to configuration property '%s' are only applied when the CRC daemon is started.\n"+
+		"If the daemon is already running, restart it for this configuration change to take effect.", key)
+}
+
+func RequiresStopAndDaemonRestartMsg(key string, _ interface{}) string {
+	return fmt.Sprintf("Changes to configuration property '%s' are written to the CRC instance when it is started or stopped, and applied when the CRC daemon and the CRC instance are started again.\n"+
+		"Stop the CRC instance with 'crc stop', restart the CRC daemon, then start the CRC instance with 'crc start' for this configuration change to take effect. "+
+		"If the CRC instance was already stopped, 'crc start' configures it, repeat these steps afterwards.", key)
+}
+
+func RequiresSetupAndRestartMsg(key string, _ interface{}) string {
+	return fmt.Sprintf("Changes to configuration property '%s' are only applied during 'crc setup' and when the CRC instance is started.\n"+
+		"Run 'crc setup', then stop the CRC instance with 'crc stop' and restart it with 'crc start' for this configuration change to take effect.", key)
+}
+
 func RequiresCRCSetup(key string, _ interface{}) string {
 	return fmt.Sprintf("Changes to configuration property '%s' are only applied during 'crc setup'.\n"+
 		"Please run 'crc setup' for this configuration to take effect.", key)
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/spf13/pflag"
+)
+
+// InstanceStorage stores the settings of a named instance on top of the
+// global configuration. Settings which are not set for the instance fall back
+// to the global configuration. Command line flags take precedence over both.
+type InstanceStorage struct {
+	lock *sync.Mutex
+
+	global   *ViperStorage
+	instance *ViperStorage
+	flagSet  *pflag.FlagSet
+}
+
+func NewInstanceStorage(global *ViperStorage) *InstanceStorage {
+	return &InstanceStorage{
+		lock:   &sync.Mutex{},
+		global: global,
+	}
+}
+
+// SetInstance selects the instance whose configuration file is used. An
+// empty configFile selects the global configuration only.
+func (s *InstanceStorage) SetInstance(configFile string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if configFile == "" {
+		s.instance = nil
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(configFile), 0750); err != nil {
+		return err
+	}
+	instance, err := NewViperStorage(configFile, s.global.envPrefix)
+	if err != nil {
+		return err
+	}
+	s.instance = instance
+	return nil
+}
+
+func (s *InstanceStorage) Get(key string) interface{} {
+	s.lock.Lock()
+	instance, flagSet := s.instance, s.flagSet
+	s.lock.Unlock()
+
+	if instance != nil && !flagChanged(flagSet, key) {
+		if value := instance.Get(key); value != nil {
+			return value
+		}
+	}
+	return s.global.Get(key)
+}
+
+func (s *InstanceStorage) Set(key string, value interface{}) error {
+	return s.current().Set(key, value)
+}
+
+func (s *InstanceStorage) Unset(key string) error {
+	return s.current().Unset(key)
+}
+
+// BindFlagset binds a flagset to their respective config properties
+func (s *InstanceStorage) BindFlagSet(flagSet *pflag.FlagSet) error {
+	s.lock.Lock()
+	s.flagSet = flagSet
+	s.lock.Unlock()
+	return s.global.BindFlagSet(flagSet)
+}
+
+func (s *InstanceStorage) current() *ViperStorage {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.instance != nil {
+		return s.instance
+	}
+	return s.global
+}
+
+func flagChanged(flagSet *pflag.FlagSet, key string) bool {
+	if flagSet == nil {
+		return false
+	}
+	flag := flagSet.Lookup(key)
+	return flag != nil && flag.Changed
+}
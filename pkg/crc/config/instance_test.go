@@ -0,0 +1,85 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestInstanceConfig(t *testing.T, dir string) (*Config, *InstanceStorage) {
+	global, err := NewViperStorage(filepath.Join(dir, "crc.json"), "CRC")
+	require.NoError(t, err)
+	storage := NewInstanceStorage(global)
+	config := New(storage)
+	config.AddSetting(cpus, 4, ValidateCPUs, RequiresRestartMsg, "")
+	config.AddSetting(nameServer, "", ValidateIPAddress, SuccessfullyApplied, "")
+	return config, storage
+}
+
+func TestInstanceConfigFallbackToGlobal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cfg")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	config, storage := newTestInstanceConfig(t, dir)
+	_, err = config.Set(cpus, 5)
+	require.NoError(t, err)
+
+	require.NoError(t, storage.SetInstance(filepath.Join(dir, "instances", "other.json")))
+	assert.Equal(t, SettingValue{
+		Value:     5,
+		IsDefault: false,
+	}, config.Get(cpus))
+
+	_, err = config.Set(cpus, 6)
+	require.NoError(t, err)
+	assert.Equal(t, SettingValue{
+		Value:     6,
+		IsDefault: false,
+	}, config.Get(cpus))
+
+	bin, err := ioutil.ReadFile(filepath.Join(dir, "crc.json"))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"cpus":5}`, string(bin))
+	bin, err = ioutil.ReadFile(filepath.Join(dir, "instances", "other.json"))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"cpus":6}`, string(bin))
+
+	_, err = config.Unset(cpus)
+	require.NoError(t, err)
+	assert.Equal(t, SettingValue{
+		Value:     5,
+		IsDefault: false,
+	}, config.Get(cpus))
+}
+
+func TestInstanceConfigFlagPrecedence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cfg")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	config, storage := newTestInstanceConfig(t, dir)
+	require.NoError(t, storage.SetInstance(filepath.Join(dir, "instances", "other.json")))
+	_, err = config.Set(cpus, 6)
+	require.NoError(t, err)
+
+	flagSet := pflag.NewFlagSet("start", pflag.ExitOnError)
+	flagSet.IntP(cpus, "c", 4, "")
+	require.NoError(t, storage.BindFlagSet(flagSet))
+
+	assert.Equal(t, SettingValue{
+		Value:     6,
+		IsDefault: false,
+	}, config.Get(cpus))
+
+	assert.NoError(t, flagSet.Set(cpus, "8"))
+	assert.Equal(t, SettingValue{
+		Value:     8,
+		IsDefault: false,
+	}, config.Get(cpus))
+}
@@ -5,6 +5,7 @@ import (
 	"runtime"
 
 	"github.com/code-ready/crc/pkg/crc/constants"
+	"github.com/code-ready/crc/pkg/crc/logging"
 	"github.com/code-ready/crc/pkg/crc/network"
 	"github.com/code-ready/crc/pkg/crc/version"
 
@@ -30,6 +31,18 @@ const (
 	EnableClusterMonitoring = "enable-cluster-monitoring"
 	AutostartTray           = "autostart-tray"
 	KubeAdminPassword       = "kubeadmin-password"
+	AutoStopAfter           = "auto-stop-after"
+	MaxCPUs                 = "max-cpus"
+	MaxMemory               = "max-memory"
+	DataDiskSize            = "data-disk-size"
+	SharedDirs              = "shared-dirs"
+	PortForwards            = "port-forwards"
+	ClusterDomain           = "cluster-domain"
+	AppsDomain              = "apps-domain"
+	VSockSubnet             = "vsock-subnet"
+	DNSRecords              = "dns-records"
+	UpstreamDNS             = "upstream-dns"
+	DNSSearchDomains        = "dns-search-domains"
 )
 
 func RegisterSettings(cfg *Config) {
@@ -49,6 +62,48 @@ func RegisterSettings(cfg *Config) {
 		return ValidateBool(value)
 	}
 
+	validateVSockSubnet := func(value interface{}) (bool, string) {
+		mode := GetNetworkMode(cfg)
+		if mode != network.UserNetworkingMode {
+			return false, fmt.Sprintf("%s can only be used with %s set to '%s'",
+				VSockSubnet, NetworkMode, network.UserNetworkingMode)
+		}
+		return ValidateVSockSubnet(value)
+	}
+
+	// the DNS server of the daemon resolves the names with the DNS servers of
+	// the host in user network mode, it can't forward the queries to others
+	validateUpstreamDNS := func(value interface{}) (bool, string) {
+		if GetNetworkMode(cfg) == network.UserNetworkingMode {
+			return false, fmt.Sprintf("%s can only be used with %s set to '%s'",
+				UpstreamDNS, NetworkMode, network.SystemNetworkingMode)
+		}
+		return ValidateUpstreamDNS(value)
+	}
+
+	// the records are served by the daemon in user network mode
+	dnsRecordsApplied := func(key string, value interface{}) string {
+		if GetNetworkMode(cfg) == network.UserNetworkingMode {
+			return RequiresDaemonRestartMsg(key, value)
+		}
+		return RequiresRestartMsg(key, value)
+	}
+
+	// the API and the routes can't share a domain, the VM DNS server answers
+	// with the same IP for all the names of the apps domain
+	validateClusterDomain := func(value interface{}) (bool, string) {
+		if cast.ToString(value) == cfg.Get(AppsDomain).AsString() {
+			return false, fmt.Sprintf("must be different from %s", AppsDomain)
+		}
+		return ValidateDomain(value)
+	}
+	validateAppsDomain := func(value interface{}) (bool, string) {
+		if cast.ToString(value) == cfg.Get(ClusterDomain).AsString() {
+			return false, fmt.Sprintf("must be different from %s", ClusterDomain)
+		}
+		return ValidateDomain(value)
+	}
+
 	disableEnableTrayAutostart := func(key string, value interface{}) string {
 		if cast.ToBool(value) {
 			return fmt.Sprintf(
@@ -71,8 +126,20 @@ func RegisterSettings(cfg *Config) {
 		fmt.Sprintf("Memory size in MiB (must be greater than or equal to '%d')", constants.DefaultMemory))
 	cfg.AddSetting(DiskSize, constants.DefaultDiskSize, ValidateDiskSize, RequiresRestartMsg,
 		fmt.Sprintf("Total size in GiB of the disk (must be greater than or equal to '%d')", constants.DefaultDiskSize))
-	cfg.AddSetting(NameServer, "", ValidateIPAddress, SuccessfullyApplied,
-		"IPv4 address of nameserver (string, like '1.1.1.1 or 8.8.8.8')")
+	if runtime.GOOS == "linux" {
+		cfg.AddSetting(MaxCPUs, 0, ValidateNonNegativeInt, RequiresRestartMsg,
+			"Maximum number of CPU cores 'crc resize' can set on the running VM (default: 0, same as cpus)")
+		cfg.AddSetting(MaxMemory, 0, ValidateNonNegativeInt, RequiresRestartMsg,
+			"Maximum memory size in MiB 'crc resize' can set on the running VM (default: 0, same as memory)")
+		cfg.AddSetting(DataDiskSize, 0, ValidateNonNegativeInt, RequiresRestartMsg,
+			"Size in GiB of the persistent disk backing the hostpath persistent volumes (default: 0, no data disk, an existing one is detached and kept)")
+		cfg.AddSetting(SharedDirs, "", ValidateSharedDirs, RequiresRestartMsg,
+			"Host directories mounted in the VM (comma separated list of <host-path>:<vm-path>, see 'crc mount')")
+	}
+	cfg.AddSetting(NameServer, "", ValidateNameServers, RequiresRestartMsg,
+		"IPv4 or IPv6 addresses of nameservers added to the VM (comma separated list, like '10.0.0.53,fd00::53', the VM uses at most 2 of them)")
+	cfg.AddSetting(DNSSearchDomains, "", ValidateSearchDomains, RequiresRestartMsg,
+		"DNS search domains added to the VM (comma separated list, like 'corp.example.com')")
 	cfg.AddSetting(PullSecretFile, "", ValidatePath, SuccessfullyApplied,
 		fmt.Sprintf("Path of image pull secret (download from %s)", constants.CrcLandingPageURL))
 	cfg.AddSetting(DisableUpdateCheck, false, ValidateBool, SuccessfullyApplied,
@@ -87,6 +154,12 @@ func RegisterSettings(cfg *Config) {
 
 	cfg.AddSetting(HostNetworkAccess, false, validateHostNetworkAccess, SuccessfullyApplied,
 		"Allow TCP/IP connections from the CodeReady Containers VM to services running on the host (true/false, default: false)")
+	cfg.AddSetting(VSockSubnet, constants.DefaultVSockSubnet, validateVSockSubnet, RequiresStopAndDaemonRestartMsg,
+		fmt.Sprintf("Subnet of the virtual network of the user network mode, the VM uses its second address, it is reconfigured by 'crc start' or 'crc stop' and uses the subnet once it is stopped and the daemon is restarted (string, default '%s')", constants.DefaultVSockSubnet))
+	cfg.AddSetting(ClusterDomain, constants.DefaultClusterDomain, validateClusterDomain, RequiresSetupAndRestartMsg,
+		fmt.Sprintf("Domain of the OpenShift API server, 'api.<cluster-domain>' (string, default '%s')", constants.DefaultClusterDomain))
+	cfg.AddSetting(AppsDomain, constants.DefaultAppsDomain, validateAppsDomain, RequiresSetupAndRestartMsg,
+		fmt.Sprintf("Domain of the routes of the OpenShift applications, including the web console (string, default '%s')", constants.DefaultAppsDomain))
 	// System tray auto-start config
 	cfg.AddSetting(AutostartTray, true, validateTrayAutostart, disableEnableTrayAutostart,
 		"Automatically start the tray (true/false, default: true)")
@@ -109,6 +182,15 @@ func RegisterSettings(cfg *Config) {
 
 	cfg.AddSetting(KubeAdminPassword, "", ValidateString, SuccessfullyApplied,
 		"User defined kubeadmin password")
+
+	cfg.AddSetting(AutoStopAfter, "0", ValidateDuration, RequiresDaemonRestartMsg,
+		"Stop the cluster after it has been idle for this duration (string, like '90m' or '2h', default: '0' to never stop)")
+	cfg.AddSetting(PortForwards, "", ValidatePortForwards, RequiresDaemonRestartMsg,
+		"Localhost ports forwarded by the daemon (comma separated list of <host-port>:<vm-port> or <host-port>:<namespace>/<service>:<port>, see 'crc port-forward')")
+	cfg.AddSetting(DNSRecords, "", ValidateDNSRecords, dnsRecordsApplied,
+		"Address records served by the DNS server of the VM, or of the daemon in user network mode (comma separated list of <name>=<ip>, see 'crc dns')")
+	cfg.AddSetting(UpstreamDNS, "", validateUpstreamDNS, RequiresRestartMsg,
+		"DNS servers the DNS server of the VM forwards the queries outside of the cluster domains to, not available in user network mode (comma separated list of IP addresses, default: the DNS servers of the host)")
 }
 
 func defaultNetworkMode() network.Mode {
@@ -121,6 +203,50 @@ func defaultNetworkMode() network.Mode {
 	return network.SystemNetworkingMode
 }
 
+// GetDomains returns the domains of the API server and of the routes set in the config
+func GetDomains(config Storage) network.Domains {
+	return network.Domains{
+		Cluster: config.Get(ClusterDomain).AsString(),
+		Apps:    config.Get(AppsDomain).AsString(),
+	}
+}
+
+// GetNameServers returns the nameservers of the nameserver setting, which was
+// validated when it was set
+func GetNameServers(config Storage) []network.NameServer {
+	nameservers, _ := network.ParseNameServers(config.Get(NameServer).AsString())
+	return nameservers
+}
+
+// GetSearchDomains returns the domains of the dns-search-domains setting
+func GetSearchDomains(config Storage) []string {
+	domains, _ := network.ParseSearchDomains(config.Get(DNSSearchDomains).AsString())
+	return domains
+}
+
+// GetDNSRecords returns the records of the dns-records setting, which was
+// validated when it was set
+func GetDNSRecords(config Storage) []network.DNSRecord {
+	records, _ := network.ParseDNSRecords(config.Get(DNSRecords).AsString())
+	return records
+}
+
+// GetUpstreamDNS returns the DNS servers of the upstream-dns setting
+func GetUpstreamDNS(config Storage) []string {
+	servers, _ := network.ParseUpstreamDNS(config.Get(UpstreamDNS).AsString())
+	return servers
+}
+
+// GetVSockNetwork returns the virtual network of the user network mode set in the config
+func GetVSockNetwork(config Storage) network.VSockNetwork {
+	vsockNetwork, err := network.ParseVSockSubnet(config.Get(VSockSubnet).AsString())
+	if err != nil {
+		logging.Errorf("unexpected %s %s, using default", VSockSubnet, config.Get(VSockSubnet).AsString())
+		return network.DefaultVSockNetwork
+	}
+	return vsockNetwork
+}
+
 func GetNetworkMode(config Storage) network.Mode {
 	if version.IsMacosInstallPathSet() {
 		return network.UserNetworkingMode
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// SharedDir is a host directory shared with the VM
+type SharedDir struct {
+	// Source is the absolute path of the directory on the host
+	Source string
+	// Target is the absolute path at which it is mounted in the VM
+	Target string
+}
+
+func (dir SharedDir) String() string {
+	return fmt.Sprintf("%s:%s", dir.Source, dir.Target)
+}
+
+// ParseSharedDirs parses a comma separated list of <host-path>:<vm-path> pairs
+func ParseSharedDirs(value string) ([]SharedDir, error) {
+	var dirs []SharedDir
+	targets := map[string]bool{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		// host paths may contain a drive letter, the VM path never contains ':'
+		index := strings.LastIndex(entry, ":")
+		if index == -1 {
+			return nil, fmt.Errorf("'%s' must be of the form <host-path>:<vm-path>", entry)
+		}
+		dir := SharedDir{
+			Source: filepath.Clean(entry[:index]),
+			Target: path.Clean(entry[index+1:]),
+		}
+		if !filepath.IsAbs(dir.Source) {
+			return nil, fmt.Errorf("host path '%s' must be absolute", entry[:index])
+		}
+		if !path.IsAbs(dir.Target) || dir.Target == "/" {
+			return nil, fmt.Errorf("VM path '%s' must be an absolute path other than '/'", entry[index+1:])
+		}
+		if targets[dir.Target] {
+			return nil, fmt.Errorf("VM path '%s' is used by more than one shared directory", dir.Target)
+		}
+		targets[dir.Target] = true
+		dirs = append(dirs, dir)
+	}
+	return dirs, nil
+}
+
+// FormatSharedDirs is the reverse of ParseSharedDirs
+func FormatSharedDirs(dirs []SharedDir) string {
+	var entries []string
+	for _, dir := range dirs {
+		entries = append(entries, dir.String())
+	}
+	return strings.Join(entries, ",")
+}
+
+// GetSharedDirs returns the directories of the shared-dirs setting, which
+// was validated when it was set
+func GetSharedDirs(config Storage) []SharedDir {
+	dirs, _ := ParseSharedDirs(config.Get(SharedDirs).AsString())
+	return dirs
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSharedDirs(t *testing.T) {
+	dirs, err := ParseSharedDirs("/home/user/src:/mnt/src, /tmp/fixtures/:/var/fixtures")
+	require.NoError(t, err)
+	assert.Equal(t, []SharedDir{
+		{Source: "/home/user/src", Target: "/mnt/src"},
+		{Source: "/tmp/fixtures", Target: "/var/fixtures"},
+	}, dirs)
+	assert.Equal(t, "/home/user/src:/mnt/src,/tmp/fixtures:/var/fixtures", FormatSharedDirs(dirs))
+
+	dirs, err = ParseSharedDirs("")
+	assert.NoError(t, err)
+	assert.Empty(t, dirs)
+
+	_, err = ParseSharedDirs("/home/user/src")
+	assert.EqualError(t, err, "'/home/user/src' must be of the form <host-path>:<vm-path>")
+	_, err = ParseSharedDirs("src:/mnt/src")
+	assert.EqualError(t, err, "host path 'src' must be absolute")
+	_, err = ParseSharedDirs("/home/user/src:/")
+	assert.EqualError(t, err, "VM path '/' must be an absolute path other than '/'")
+	_, err = ParseSharedDirs("/home/user/src:/mnt/src,/tmp:/mnt/src/")
+	assert.EqualError(t, err, "VM path '/mnt/src' is used by more than one shared directory")
+}
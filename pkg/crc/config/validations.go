@@ -2,10 +2,13 @@ package config
 
 import (
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/code-ready/crc/pkg/crc/constants"
 	"github.com/code-ready/crc/pkg/crc/network"
+	"github.com/code-ready/crc/pkg/crc/portforward"
 	"github.com/code-ready/crc/pkg/crc/validation"
 	"github.com/spf13/cast"
 )
@@ -64,6 +67,15 @@ func ValidateMemory(value interface{}) (bool, string) {
 	return true, ""
 }
 
+// ValidateNonNegativeInt checks if the value is an integer >= 0
+func ValidateNonNegativeInt(value interface{}) (bool, string) {
+	v, err := cast.ToIntE(value)
+	if err != nil || v < 0 {
+		return false, "requires integer value >= 0"
+	}
+	return true, ""
+}
+
 // ValidateBundlePath checks if the provided bundle path is valid
 func ValidateBundlePath(value interface{}) (bool, string) {
 	if err := validation.ValidateBundlePath(cast.ToString(value)); err != nil {
@@ -80,6 +92,22 @@ func ValidateIPAddress(value interface{}) (bool, string) {
 	return true, ""
 }
 
+// ValidateNameServers checks if the value is a list of IP addresses
+func ValidateNameServers(value interface{}) (bool, string) {
+	if _, err := network.ParseNameServers(cast.ToString(value)); err != nil {
+		return false, err.Error()
+	}
+	return true, ""
+}
+
+// ValidateSearchDomains checks if the value is a list of DNS domains
+func ValidateSearchDomains(value interface{}) (bool, string) {
+	if _, err := network.ParseSearchDomains(cast.ToString(value)); err != nil {
+		return false, err.Error()
+	}
+	return true, ""
+}
+
 // ValidatePath checks if provided path is exist
 func ValidatePath(value interface{}) (bool, string) {
 	if err := validation.ValidatePath(cast.ToString(value)); err != nil {
@@ -96,6 +124,27 @@ func ValidateURI(value interface{}) (bool, string) {
 	return true, ""
 }
 
+// ValidateDomain checks if the value is a valid DNS domain
+func ValidateDomain(value interface{}) (bool, string) {
+	if err := validation.ValidateDomain(cast.ToString(value)); err != nil {
+		return false, err.Error()
+	}
+	return true, ""
+}
+
+// ValidateVSockSubnet checks if the value is an IPv4 subnet which can be used
+// for the user mode network and which does not overlap the routes of the host
+func ValidateVSockSubnet(value interface{}) (bool, string) {
+	vsockNetwork, err := network.ParseVSockSubnet(cast.ToString(value))
+	if err != nil {
+		return false, err.Error()
+	}
+	if err := network.CheckSubnetAgainstHostRoutes(vsockNetwork.Subnet()); err != nil {
+		return false, err.Error()
+	}
+	return true, ""
+}
+
 // ValidateNoProxy checks if the NoProxy string has the correct format
 func ValidateNoProxy(value interface{}) (bool, string) {
 	if strings.Contains(cast.ToString(value), " ") {
@@ -104,6 +153,55 @@ func ValidateNoProxy(value interface{}) (bool, string) {
 	return true, ""
 }
 
+// ValidateDuration checks if the value is a non-negative duration such as
+// '90m' or '2h'
+func ValidateDuration(value interface{}) (bool, string) {
+	duration, err := time.ParseDuration(cast.ToString(value))
+	if err != nil || duration < 0 {
+		return false, "must be a duration such as '90m' or '2h'"
+	}
+	return true, ""
+}
+
+// ValidateSharedDirs checks if the value is a list of <host-path>:<vm-path>
+// pairs with existing host directories
+func ValidateSharedDirs(value interface{}) (bool, string) {
+	dirs, err := ParseSharedDirs(cast.ToString(value))
+	if err != nil {
+		return false, err.Error()
+	}
+	for _, dir := range dirs {
+		if info, err := os.Stat(dir.Source); err != nil || !info.IsDir() {
+			return false, fmt.Sprintf("host directory '%s' does not exist", dir.Source)
+		}
+	}
+	return true, ""
+}
+
+// ValidatePortForwards checks if the value is a list of port forwards
+func ValidatePortForwards(value interface{}) (bool, string) {
+	if _, err := portforward.ParseList(cast.ToString(value)); err != nil {
+		return false, err.Error()
+	}
+	return true, ""
+}
+
+// ValidateDNSRecords checks if the value is a list of <name>=<ip> records
+func ValidateDNSRecords(value interface{}) (bool, string) {
+	if _, err := network.ParseDNSRecords(cast.ToString(value)); err != nil {
+		return false, err.Error()
+	}
+	return true, ""
+}
+
+// ValidateUpstreamDNS checks if the value is a list of IP addresses
+func ValidateUpstreamDNS(value interface{}) (bool, string) {
+	if _, err := network.ParseUpstreamDNS(cast.ToString(value)); err != nil {
+		return false, err.Error()
+	}
+	return true, ""
+}
+
 func ValidateYesNo(value interface{}) (bool, string) {
 	if cast.ToString(value) == "yes" || cast.ToString(value) == "no" {
 		return true, ""
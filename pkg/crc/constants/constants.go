@@ -34,13 +34,17 @@ const (
 	DefaultContext            = "admin"
 	DaemonHTTPEndpoint        = "http://unix/api"
 
-	VSockGateway = "192.168.127.1"
-	VsockSSHPort = 2222
+	DefaultVSockSubnet = "192.168.127.0/24"
+	VsockSSHPort       = 2222
 
 	OkdPullSecret = `{"auths":{"fake":{"auth": "Zm9vOmJhcgo="}}}` // #nosec G101
 
-	ClusterDomain = ".crc.testing"
-	AppsDomain    = ".apps-crc.testing"
+	DefaultClusterDomain = "crc.testing"
+	DefaultAppsDomain    = "apps-crc.testing"
+
+	// ClusterDomain and AppsDomain are the domains the bundles are built with
+	ClusterDomain = "." + DefaultClusterDomain
+	AppsDomain    = "." + DefaultAppsDomain
 )
 
 var adminHelperExecutableForOs = map[string]string{
@@ -97,7 +101,7 @@ var (
 	MachineInstanceDir = filepath.Join(MachineBaseDir, "machines")
 	DefaultBundlePath  = defaultBundlePath()
 	DaemonSocketPath   = filepath.Join(CrcBaseDir, "crc.sock")
-	KubeconfigFilePath = filepath.Join(MachineInstanceDir, DefaultName, "kubeconfig")
+	InstanceConfigDir  = filepath.Join(CrcBaseDir, "instances")
 )
 
 func defaultBundlePath() string {
@@ -166,21 +170,58 @@ func contains(arr []string, str string) bool {
 	return false
 }
 
-func GetPublicKeyPath() string {
-	return filepath.Join(MachineInstanceDir, DefaultName, "id_ecdsa.pub")
+// GetInstanceDir returns the directory in which the files of the named instance are stored
+func GetInstanceDir(name string) string {
+	return filepath.Join(MachineInstanceDir, name)
+}
+
+// GetDataDiskPath returns the path of the data disk image of the named
+// instance. It is kept out of the instance directory so that it survives
+// 'crc delete'.
+func GetDataDiskPath(name string) string {
+	return filepath.Join(MachineBaseDir, "disks", fmt.Sprintf("%s-data.qcow2", name))
+}
+
+// GetInstanceConfigPath returns the path of the configuration file specific to the named instance
+func GetInstanceConfigPath(name string) string {
+	return filepath.Join(InstanceConfigDir, fmt.Sprintf("%s.json", name))
+}
+
+func GetKubeconfigFilePath(name string) string {
+	return filepath.Join(GetInstanceDir(name), "kubeconfig")
 }
 
-func GetPrivateKeyPath() string {
-	return filepath.Join(MachineInstanceDir, DefaultName, "id_ecdsa")
+func GetPublicKeyPath(name string) string {
+	return filepath.Join(GetInstanceDir(name), "id_ecdsa.pub")
+}
+
+func GetPrivateKeyPath(name string) string {
+	return filepath.Join(GetInstanceDir(name), "id_ecdsa")
 }
 
 // For backward compatibility to v 1.20.0
-func GetRsaPrivateKeyPath() string {
-	return filepath.Join(MachineInstanceDir, DefaultName, "id_rsa")
+func GetRsaPrivateKeyPath(name string) string {
+	return filepath.Join(GetInstanceDir(name), "id_rsa")
+}
+
+func GetIngressCAPath(name string) string {
+	return filepath.Join(GetInstanceDir(name), "ingress-ca.crt")
+}
+
+func GetKubeAdminPasswordPath(name string) string {
+	return filepath.Join(GetInstanceDir(name), "kubeadmin-password")
+}
+
+// GetSharedDirsPath returns the path of the file recording the shared
+// directories added to the definition of the named instance
+func GetSharedDirsPath(name string) string {
+	return filepath.Join(GetInstanceDir(name), "shared-dirs")
 }
 
-func GetKubeAdminPasswordPath() string {
-	return filepath.Join(MachineInstanceDir, DefaultName, "kubeadmin-password")
+// GetVSockSubnetPath returns the path of the file recording the subnet the user
+// mode network of the named instance is configured for
+func GetVSockSubnetPath(name string) string {
+	return filepath.Join(GetInstanceDir(name), "vsock-subnet")
 }
 
 // TODO: follow the same pattern as oc and podman above
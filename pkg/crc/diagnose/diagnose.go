@@ -0,0 +1,252 @@
+package diagnose
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/code-ready/crc/pkg/compress"
+	"github.com/code-ready/crc/pkg/crc/cluster"
+	crcConfig "github.com/code-ready/crc/pkg/crc/config"
+	"github.com/code-ready/crc/pkg/crc/constants"
+	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/machine"
+	"github.com/code-ready/crc/pkg/crc/machine/bundle"
+	"github.com/code-ready/crc/pkg/crc/machine/state"
+	"github.com/code-ready/crc/pkg/crc/preflight"
+	crcos "github.com/code-ready/crc/pkg/os"
+)
+
+// journalSince limits the size of the journals of the VM services
+const journalSince = 24 * time.Hour
+
+var vmUnits = []string{"kubelet", "crio"}
+
+// Collect writes a tarball with the logs, the configuration and the state of
+// the host and of the VM to destination. The secrets are redacted.
+func Collect(ctx context.Context, config crcConfig.Storage, client machine.Client, destination string) error {
+	tmpDir, err := ioutil.TempDir("", "crc-diagnose")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// the top-level directory of the tarball is named after it
+	dir := filepath.Join(tmpDir, strings.TrimSuffix(filepath.Base(destination), ".tar.zst"))
+	if err := os.Mkdir(dir, 0700); err != nil {
+		return err
+	}
+
+	c := &collector{
+		dir:      dir,
+		name:     client.GetName(),
+		redactor: newRedactor(secrets(config, client.GetName())...),
+	}
+	if pullSecret, err := cluster.NewNonInteractivePullSecretLoader(config, "").Value(); err == nil {
+		c.redactor.addPullSecret(pullSecret)
+	}
+
+	c.collectLogs()
+	c.collectConfig(config)
+	c.collectPreflight(config)
+	c.collectMachine()
+	c.collectVM(ctx, client)
+	c.writeErrors()
+
+	return compress.Compress(dir, destination)
+}
+
+func secrets(config crcConfig.Storage, name string) []string {
+	secrets := []string{config.Get(crcConfig.KubeAdminPassword).AsString()}
+	if password, err := ioutil.ReadFile(constants.GetKubeAdminPasswordPath(name)); err == nil {
+		secrets = append(secrets, strings.TrimSpace(string(password)))
+	}
+	return secrets
+}
+
+type collector struct {
+	dir      string
+	name     string
+	redactor *redactor
+	errors   []string
+}
+
+// write redacts the data and writes it to the named file of the bundle
+func (c *collector) write(name string, data []byte) {
+	path := filepath.Join(c.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		c.fail(name, err)
+		return
+	}
+	if err := ioutil.WriteFile(path, c.redactor.redact(data), 0600); err != nil {
+		c.fail(name, err)
+	}
+}
+
+func (c *collector) writeJSON(name string, value interface{}) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		c.fail(name, err)
+		return
+	}
+	c.write(name, data)
+}
+
+func (c *collector) copyFile(name, source string) {
+	data, err := ioutil.ReadFile(source)
+	if err != nil {
+		c.fail(name, err)
+		return
+	}
+	c.write(name, data)
+}
+
+// fail records what could not be collected, the bundle is still written
+func (c *collector) fail(name string, err error) {
+	logging.Debugf("Cannot collect %s: %v", name, err)
+	c.errors = append(c.errors, fmt.Sprintf("%s: %v", name, err))
+}
+
+func (c *collector) writeErrors() {
+	if len(c.errors) > 0 {
+		c.write("errors.txt", []byte(strings.Join(c.errors, "\n")+"\n"))
+	}
+}
+
+// collectLogs copies crc.log, crcd.log and their backups
+func (c *collector) collectLogs() {
+	for _, logFile := range []string{constants.LogFilePath, constants.DaemonLogFilePath} {
+		matches, err := filepath.Glob(logFile + "*")
+		if err != nil {
+			c.fail(logFile, err)
+			continue
+		}
+		for _, match := range matches {
+			c.copyFile(filepath.Join("logs", filepath.Base(match)), match)
+		}
+	}
+}
+
+// collectConfig writes the output of 'crc config view'
+func (c *collector) collectConfig(config crcConfig.Storage) {
+	var lines []string
+	for key, value := range config.AllConfigs() {
+		if value.IsDefault {
+			continue
+		}
+		if key == crcConfig.KubeAdminPassword {
+			lines = append(lines, fmt.Sprintf("- %-38s: %s", key, redacted))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("- %-38s: %s", key, value.AsString()))
+	}
+	sort.Strings(lines)
+	c.write("config.txt", []byte(strings.Join(lines, "\n")+"\n"))
+}
+
+func (c *collector) collectPreflight(config crcConfig.Storage) {
+	c.writeJSON("preflight.json", preflight.CheckHost(config))
+}
+
+// collectMachine copies the libmachine host and the metadata of its bundle
+func (c *collector) collectMachine() {
+	data, err := ioutil.ReadFile(filepath.Join(constants.MachineInstanceDir, c.name, "config.json"))
+	if err != nil {
+		c.fail("machine/config.json", err)
+		return
+	}
+	c.write("machine/config.json", data)
+
+	var host struct {
+		Driver struct {
+			BundleName string
+		}
+	}
+	if err := json.Unmarshal(data, &host); err != nil {
+		c.fail("bundle/crc-bundle-info.json", err)
+		return
+	}
+	metadata, err := bundle.Get(host.Driver.BundleName)
+	if err != nil {
+		c.fail("bundle/crc-bundle-info.json", err)
+		return
+	}
+	c.writeJSON("bundle/crc-bundle-info.json", metadata)
+}
+
+// collectVM gets the journals and the state of the cluster when the VM is running
+func (c *collector) collectVM(ctx context.Context, client machine.Client) {
+	status, err := client.Status()
+	if err != nil {
+		c.fail("status", err)
+		return
+	}
+	c.writeJSON("status.json", status)
+	if status.CrcStatus != state.Running {
+		return
+	}
+
+	for _, unit := range vmUnits {
+		var logs strings.Builder
+		if err := client.VMLogs(ctx, unit, journalSince, false, &logs); err != nil {
+			c.fail(fmt.Sprintf("vm/%s.log", unit), err)
+			continue
+		}
+		c.write(fmt.Sprintf("vm/%s.log", unit), []byte(logs.String()))
+	}
+
+	connectionDetails, err := client.ConnectionDetails()
+	if err != nil {
+		c.fail("cluster/operators.json", err)
+		return
+	}
+	operatorsStatus, operators, err := cluster.GetClusterOperators(ctx, connectionDetails.IP, constants.GetKubeconfigFilePath(c.name))
+	if err != nil {
+		c.fail("cluster/operators.json", err)
+		return
+	}
+	c.writeJSON("cluster/operators.json", struct {
+		Message   string
+		Operators []cluster.OperatorStatus
+	}{
+		Message:   operatorsStatus.String(),
+		Operators: operators,
+	})
+	if len(operatorsStatus.DegradedOperators()) > 0 {
+		c.inspect(operatorsStatus.DegradedOperators())
+	}
+}
+
+// inspect runs 'oc adm inspect' on the degraded operators
+func (c *collector) inspect(operators []string) {
+	destDir := filepath.Join(c.dir, "cluster", "inspect")
+	args := []string{"adm", "inspect", "--kubeconfig", constants.GetKubeconfigFilePath(c.name), "--dest-dir", destDir}
+	for _, operator := range operators {
+		args = append(args, "clusteroperator/"+operator)
+	}
+	ocPath := filepath.Join(constants.CrcOcBinDir, constants.OcExecutableName)
+	if _, stderr, err := crcos.NewLocalCommandRunner().Run(ocPath, args...); err != nil {
+		c.fail("cluster/inspect", fmt.Errorf("%v: %s", err, stderr))
+	}
+
+	// oc writes the files itself, redact them in place
+	err := filepath.Walk(destDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(path, c.redactor.redact(data), info.Mode())
+	})
+	if err != nil && !os.IsNotExist(err) {
+		c.fail("cluster/inspect", err)
+	}
+}
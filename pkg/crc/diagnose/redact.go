@@ -0,0 +1,78 @@
+package diagnose
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+)
+
+const redacted = "<redacted>"
+
+// minSecretLength avoids replacing every occurrence of a trivial string
+const minSecretLength = 6
+
+var redactions = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// pull secrets, bundle metadata and other JSON documents
+	{
+		pattern:     regexp.MustCompile(`("(?:auth|password|token|openshiftPullSecret)"\s*:\s*)"(?:[^"\\]|\\.)*"`),
+		replacement: `${1}"` + redacted + `"`,
+	},
+	// credentials of the proxy URLs
+	{
+		pattern:     regexp.MustCompile(`(://[^/:@\s]+):[^/@\s]+@`),
+		replacement: "${1}:" + redacted + "@",
+	},
+	// key=value and key: value pairs of the logs and of the YAML documents
+	{
+		pattern:     regexp.MustCompile(`(?i)((?:password|passwd|secret|token)["']?\s*[:=]\s*)[^\s"',]+`),
+		replacement: "${1}" + redacted,
+	},
+}
+
+// redactor removes the pull secret, the passwords and the tokens from the
+// files of the support bundle
+type redactor struct {
+	secrets [][]byte
+}
+
+func newRedactor(secrets ...string) *redactor {
+	r := &redactor{}
+	for _, secret := range secrets {
+		if len(secret) >= minSecretLength {
+			r.secrets = append(r.secrets, []byte(secret))
+		}
+	}
+	return r
+}
+
+// addPullSecret registers the pull secret and each of its registry credentials
+func (r *redactor) addPullSecret(pullSecret string) {
+	var parsed struct {
+		Auths map[string]struct {
+			Auth string `json:"auth"`
+		} `json:"auths"`
+	}
+	if len(pullSecret) >= minSecretLength {
+		r.secrets = append(r.secrets, []byte(pullSecret))
+	}
+	if err := json.Unmarshal([]byte(pullSecret), &parsed); err == nil {
+		for _, registry := range parsed.Auths {
+			if len(registry.Auth) >= minSecretLength {
+				r.secrets = append(r.secrets, []byte(registry.Auth))
+			}
+		}
+	}
+}
+
+func (r *redactor) redact(data []byte) []byte {
+	for _, secret := range r.secrets {
+		data = bytes.ReplaceAll(data, secret, []byte(redacted))
+	}
+	for _, redaction := range redactions {
+		data = redaction.pattern.ReplaceAll(data, []byte(redaction.replacement))
+	}
+	return data
+}
@@ -0,0 +1,31 @@
+package keyring
+
+import (
+	"github.com/zalando/go-keyring"
+)
+
+// service groups the secrets of crc in the keyring of the system
+const service = "crc"
+
+// ErrNotFound is returned by Get when the secret is not in the keyring
+var ErrNotFound = keyring.ErrNotFound
+
+// Get returns the secret stored under key
+func Get(key string) (string, error) {
+	return keyring.Get(service, key)
+}
+
+// Set stores the secret under key, replacing the previous one
+func Set(key, secret string) error {
+	return keyring.Set(service, key, secret)
+}
+
+// Delete removes the secret stored under key, it returns false if there was
+// none
+func Delete(key string) (bool, error) {
+	err := keyring.Delete(service, key)
+	if err == keyring.ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,29 @@
+package keyring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func TestKeyring(t *testing.T) {
+	keyring.MockInit()
+
+	_, err := Get("secret")
+	assert.Equal(t, ErrNotFound, err)
+
+	require.NoError(t, Set("secret", "value"))
+	secret, err := Get("secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "value", secret)
+
+	deleted, err := Delete("secret")
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+
+	deleted, err = Delete("secret")
+	assert.NoError(t, err)
+	assert.False(t, deleted)
+}
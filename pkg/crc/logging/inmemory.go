@@ -9,8 +9,9 @@ import (
 
 // This hook keeps in memory n messages from error to info level
 type inMemoryHook struct {
-	messages *ring.Ring
-	lock     sync.RWMutex
+	messages  *ring.Ring
+	listeners []func(message string)
+	lock      sync.RWMutex
 }
 
 func newInMemoryHook(size int) *inMemoryHook {
@@ -25,12 +26,25 @@ func (h *inMemoryHook) Levels() []logrus.Level {
 
 func (h *inMemoryHook) Fire(entry *logrus.Entry) error {
 	h.lock.Lock()
-	defer h.lock.Unlock()
 	h.messages.Value = entry.Message
 	h.messages = h.messages.Next()
+	listeners := h.listeners
+	h.lock.Unlock()
+
+	for _, listener := range listeners {
+		listener(entry.Message)
+	}
 	return nil
 }
 
+// AddListener registers a function called with each new message. It must
+// not block nor log anything.
+func (h *inMemoryHook) AddListener(listener func(message string)) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.listeners = append(h.listeners, listener)
+}
+
 func (h *inMemoryHook) Messages() []string {
 	h.lock.RLock()
 	defer h.lock.RUnlock()
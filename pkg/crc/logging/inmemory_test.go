@@ -22,6 +22,20 @@ func TestAddLogs(t *testing.T) {
 	assert.Equal(t, []string{"message 5", "message 6", "message 7", "message 8", "message 9"}, memory.Messages())
 }
 
+func TestListener(t *testing.T) {
+	memory := newInMemoryHook(5)
+
+	var received []string
+	memory.AddListener(func(message string) {
+		received = append(received, message)
+	})
+	assert.NoError(t, memory.Fire(&logrus.Entry{
+		Message: "message 1",
+	}))
+
+	assert.Equal(t, []string{"message 1"}, received)
+}
+
 func TestRace(t *testing.T) {
 	memory := newInMemoryHook(5)
 
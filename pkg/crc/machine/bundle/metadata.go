@@ -25,6 +25,10 @@ type CrcBundleInfo struct {
 	DriverInfo  DriverInfo  `json:"driverInfo"`
 
 	cachedPath string
+
+	// domains used instead of the ones of ClusterInfo by the hostname getters
+	clusterDomain string
+	appsDomain    string
 }
 
 type BuildInfo struct {
@@ -93,12 +97,49 @@ func (bundle *CrcBundleInfo) GetBundleName() string {
 	return bundle.Name
 }
 
+// UseDomains makes the hostname getters use the given domains instead of the
+// ones the cluster of the bundle was installed with
+func (bundle *CrcBundleInfo) UseDomains(clusterDomain, appsDomain string) {
+	bundle.clusterDomain = clusterDomain
+	bundle.appsDomain = appsDomain
+}
+
+func (bundle *CrcBundleInfo) GetClusterDomain() string {
+	if bundle.clusterDomain != "" {
+		return bundle.clusterDomain
+	}
+	return bundle.getBuiltinClusterDomain()
+}
+
+func (bundle *CrcBundleInfo) GetAppsDomain() string {
+	if bundle.appsDomain != "" {
+		return bundle.appsDomain
+	}
+	return bundle.ClusterInfo.AppsDomain
+}
+
+// HasCustomDomains returns true when UseDomains set domains which differ from
+// the ones of the cluster of the bundle
+func (bundle *CrcBundleInfo) HasCustomDomains() bool {
+	return bundle.GetClusterDomain() != bundle.getBuiltinClusterDomain() || bundle.GetAppsDomain() != bundle.ClusterInfo.AppsDomain
+}
+
+func (bundle *CrcBundleInfo) getBuiltinClusterDomain() string {
+	return fmt.Sprintf("%s.%s", bundle.ClusterInfo.ClusterName, bundle.ClusterInfo.BaseDomain)
+}
+
 func (bundle *CrcBundleInfo) GetAPIHostname() string {
-	return fmt.Sprintf("api.%s.%s", bundle.ClusterInfo.ClusterName, bundle.ClusterInfo.BaseDomain)
+	return fmt.Sprintf("api.%s", bundle.GetClusterDomain())
+}
+
+// GetBuiltinAPIHostname returns the hostname the certificate of the API server
+// is valid for, it is not changed by UseDomains
+func (bundle *CrcBundleInfo) GetBuiltinAPIHostname() string {
+	return fmt.Sprintf("api.%s", bundle.getBuiltinClusterDomain())
 }
 
 func (bundle *CrcBundleInfo) GetAppHostname(appName string) string {
-	return fmt.Sprintf("%s.%s", appName, bundle.ClusterInfo.AppsDomain)
+	return fmt.Sprintf("%s.%s", appName, bundle.GetAppsDomain())
 }
 
 func (bundle *CrcBundleInfo) GetDiskImagePath() string {
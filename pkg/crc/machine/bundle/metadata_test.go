@@ -163,3 +163,20 @@ func TestCustomBundleName(t *testing.T) {
 	customBundleName = GetCustomBundleName(customBundleName)
 	checkBundleName(t, customBundleName)
 }
+
+func TestUseDomains(t *testing.T) {
+	bundle := parsedReference
+	assert.Equal(t, "api.crc.testing", bundle.GetAPIHostname())
+	assert.Equal(t, "console-openshift-console.apps-crc.testing", bundle.GetAppHostname("console-openshift-console"))
+	assert.False(t, bundle.HasCustomDomains())
+
+	bundle.UseDomains("crc.testing", "apps-crc.testing")
+	assert.False(t, bundle.HasCustomDomains())
+
+	bundle.UseDomains("crc.example.lan", "apps.example.lan")
+	assert.True(t, bundle.HasCustomDomains())
+	assert.Equal(t, "api.crc.example.lan", bundle.GetAPIHostname())
+	assert.Equal(t, "console-openshift-console.apps.example.lan", bundle.GetAppHostname("console-openshift-console"))
+	assert.Equal(t, "api.crc.testing", bundle.GetBuiltinAPIHostname())
+	assert.Equal(t, "apps-crc.testing", bundle.ClusterInfo.AppsDomain)
+}
@@ -55,7 +55,7 @@ func (repo *Repository) Get(bundleName string) (*CrcBundleInfo, error) {
 	if fmt.Sprintf(".%s", bundleInfo.ClusterInfo.AppsDomain) != constants.AppsDomain {
 		return nil, fmt.Errorf("unexpected bundle, it must have %s apps domain", constants.AppsDomain)
 	}
-	if bundleInfo.GetAPIHostname() != fmt.Sprintf("api%s", constants.ClusterDomain) {
+	if bundleInfo.GetBuiltinAPIHostname() != fmt.Sprintf("api%s", constants.ClusterDomain) {
 		return nil, fmt.Errorf("unexpected bundle, it must have %s base domain", constants.ClusterDomain)
 	}
 	return &bundleInfo, nil
@@ -0,0 +1,101 @@
+package machine
+
+import (
+	"github.com/code-ready/crc/pkg/crc/cluster"
+	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/oc"
+	crcssh "github.com/code-ready/crc/pkg/crc/ssh"
+	"github.com/code-ready/crc/pkg/crc/systemd"
+	"github.com/pkg/errors"
+)
+
+// CertsStatus returns the expiry date of the certificates of the cluster
+func (client *client) CertsStatus() ([]cluster.CertExpiry, error) {
+	_, sshRunner, err := loadVM(client)
+	if err != nil {
+		return nil, errors.Wrap(err, "The cluster must be running to read its certificates")
+	}
+	defer sshRunner.Close()
+
+	return cluster.GetCertsExpiry(sshRunner, oc.UseOCWithSSH(sshRunner)), nil
+}
+
+const kubeletCertBackupSuffix = ".crc-backup"
+
+var kubeletCerts = []string{cluster.KubeletClientCert, cluster.KubeletServerCert}
+
+// RenewCerts makes the kubelet request new client and serving certificates,
+// approves the signing requests and waits for the new certificates to be used.
+// The current certificates are restored if the renewal fails.
+func (client *client) RenewCerts() error {
+	_, sshRunner, err := loadVM(client)
+	if err != nil {
+		return errors.Wrap(err, "The cluster must be running to renew its certificates")
+	}
+	defer sshRunner.Close()
+
+	sd := systemd.NewInstanceSystemdCommander(sshRunner)
+	if err := sd.Stop("kubelet"); err != nil {
+		return errors.Wrap(err, "Error stopping kubelet")
+	}
+	// without its current certificates, the kubelet bootstraps and asks for new ones
+	if err := backupKubeletCerts(sshRunner); err != nil {
+		if startErr := sd.Start("kubelet"); startErr != nil {
+			logging.Errorf("Error starting kubelet: %v", startErr)
+		}
+		return errors.Wrap(err, "Failed to move the kubelet certificates aside")
+	}
+	if err := renewKubeletCerts(sshRunner, sd); err != nil {
+		logging.Warn("Restoring the previous kubelet certificates")
+		if err := sd.Stop("kubelet"); err != nil {
+			logging.Debugf("Error stopping kubelet: %v", err)
+		}
+		if restoreErr := restoreKubeletCerts(sshRunner, kubeletCerts); restoreErr != nil {
+			logging.Errorf("Failed to restore the kubelet certificates: %v", restoreErr)
+		}
+		if startErr := sd.Start("kubelet"); startErr != nil {
+			logging.Errorf("Error starting kubelet: %v", startErr)
+		}
+		return err
+	}
+	for _, cert := range kubeletCerts {
+		if _, _, err := sshRunner.RunPrivileged("Removing the previous kubelet certificate", "rm", "-f", cert+kubeletCertBackupSuffix); err != nil {
+			logging.Debugf("Failed to remove %s: %v", cert+kubeletCertBackupSuffix, err)
+		}
+	}
+	return nil
+}
+
+func renewKubeletCerts(sshRunner *crcssh.Runner, sd *systemd.Commander) error {
+	logging.Info("Starting OpenShift kubelet service")
+	if err := sd.Start("kubelet"); err != nil {
+		return errors.Wrap(err, "Error starting kubelet")
+	}
+	if err := cluster.ApproveCSRAndWaitForCertsRenewal(sshRunner, oc.UseOCWithSSH(sshRunner), true, true); err != nil {
+		return errors.Wrap(err, "Failed to renew the kubelet certificates")
+	}
+	return nil
+}
+
+// backupKubeletCerts moves the kubelet certificates aside, the ones already
+// moved are restored if one of them cannot be moved
+func backupKubeletCerts(sshRunner *crcssh.Runner) error {
+	for i, cert := range kubeletCerts {
+		if _, _, err := sshRunner.RunPrivileged("Moving aside the kubelet certificate", "mv", "-f", cert, cert+kubeletCertBackupSuffix); err != nil {
+			if restoreErr := restoreKubeletCerts(sshRunner, kubeletCerts[:i]); restoreErr != nil {
+				logging.Errorf("Failed to restore the kubelet certificates: %v", restoreErr)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func restoreKubeletCerts(sshRunner *crcssh.Runner, certs []string) error {
+	for _, cert := range certs {
+		if _, _, err := sshRunner.RunPrivileged("Restoring the kubelet certificate", "mv", "-f", cert+kubeletCertBackupSuffix, cert); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,12 +2,16 @@ package machine
 
 import (
 	"context"
+	"io"
+	"sync"
 	"time"
 
+	"github.com/code-ready/crc/pkg/crc/cluster"
 	crcConfig "github.com/code-ready/crc/pkg/crc/config"
 	"github.com/code-ready/crc/pkg/crc/machine/state"
 	"github.com/code-ready/crc/pkg/crc/machine/types"
 	"github.com/code-ready/crc/pkg/crc/network"
+	crcssh "github.com/code-ready/crc/pkg/crc/ssh"
 	"github.com/kofalt/go-memoize"
 )
 
@@ -22,8 +26,20 @@ type Client interface {
 	Start(ctx context.Context, startConfig types.StartConfig) (*types.StartResult, error)
 	Status() (*types.ClusterStatusResult, error)
 	Stop() (state.State, error)
+	Pause() error
+	Resume() error
+	Resize(memory, cpus int) error
 	IsRunning() (bool, error)
 	GenerateBundle(forceStop bool) error
+	CompactDisk() (int64, error)
+	VMLogs(ctx context.Context, unit string, since time.Duration, follow bool, writer io.Writer) error
+	CertsStatus() ([]cluster.CertExpiry, error)
+	RenewCerts() error
+
+	SaveSnapshot(snapshot string) error
+	ListSnapshots() ([]types.SnapshotDetails, error)
+	RestoreSnapshot(snapshot string) error
+	DeleteSnapshot(snapshot string) error
 }
 
 type client struct {
@@ -31,15 +47,20 @@ type client struct {
 	debug  bool
 	config crcConfig.Storage
 
-	diskDetails *memoize.Memoizer
+	// disk and resource usage of the VM, collected over SSH
+	vmDetails *memoize.Memoizer
+
+	// SSH connection reused by the Status calls
+	statusRunnerLock sync.Mutex
+	statusRunner     *crcssh.Runner
 }
 
 func NewClient(name string, debug bool, config crcConfig.Storage) Client {
 	return &client{
-		name:        name,
-		debug:       debug,
-		config:      config,
-		diskDetails: memoize.NewMemoizer(time.Minute, 5*time.Minute),
+		name:      name,
+		debug:     debug,
+		config:    config,
+		vmDetails: memoize.NewMemoizer(time.Minute, 5*time.Minute),
 	}
 }
 
@@ -55,6 +76,22 @@ func (client *client) networkMode() network.Mode {
 	return crcConfig.GetNetworkMode(client.config)
 }
 
+func (client *client) vsockNetwork() network.VSockNetwork {
+	return crcConfig.GetVSockNetwork(client.config)
+}
+
+func (client *client) dnsRecords() []network.DNSRecord {
+	return crcConfig.GetDNSRecords(client.config)
+}
+
+func (client *client) upstreamDNS() []string {
+	return crcConfig.GetUpstreamDNS(client.config)
+}
+
+func (client *client) domains() network.Domains {
+	return crcConfig.GetDomains(client.config)
+}
+
 func (client *client) monitoringEnabled() bool {
 	return client.config.Get(crcConfig.EnableClusterMonitoring).AsBool()
 }
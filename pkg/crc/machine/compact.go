@@ -0,0 +1,55 @@
+package machine
+
+import (
+	"fmt"
+
+	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/pkg/errors"
+)
+
+// CompactDisk trims the unused blocks of the guest filesystems, stops the VM
+// and rewrites its disk image without them. It returns the number of bytes
+// reclaimed on the host. The trimmed blocks only reach the disk image when
+// the VM was started with discard enabled, otherwise discard is enabled for
+// the next start and only the image is rewritten.
+func (client *client) CompactDisk() (int64, error) {
+	if err := diskCompactionSupported(); err != nil {
+		return 0, err
+	}
+	// the rewritten image would lose the internal qcow2 snapshots
+	snapshots, err := client.ListSnapshots()
+	if err != nil {
+		return 0, err
+	}
+	if len(snapshots) > 0 {
+		return 0, fmt.Errorf("Cannot compact the disk of an instance with snapshots, delete them first with 'crc snapshot delete'")
+	}
+
+	_, sshRunner, err := loadVM(client)
+	if err != nil {
+		return 0, errors.Wrap(err, "The cluster must be running to find the unused disk blocks")
+	}
+	defer sshRunner.Close()
+
+	discardActive, err := enableDiskDiscard(client.name)
+	if err != nil {
+		logging.Warnf("Cannot enable discard on the VM disk, less space may be reclaimed: %v", err)
+	}
+
+	if discardActive {
+		logging.Info("Trimming the unused blocks of the VM filesystems...")
+		if _, _, err := sshRunner.RunPrivileged("Trimming the filesystems", "fstrim", "--all", "--verbose"); err != nil {
+			return 0, errors.Wrap(err, "Failed to trim the filesystems")
+		}
+	} else {
+		logging.Warn("The VM was started without discard, the space of the deleted files cannot be reclaimed now: " +
+			"run 'crc start' and 'crc disk compact' again to reclaim it")
+	}
+
+	if _, err := client.Stop(); err != nil {
+		return 0, err
+	}
+
+	logging.Info("Compacting the disk image...")
+	return compactDiskImage(client.name)
+}
@@ -0,0 +1,238 @@
+package machine
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/code-ready/crc/pkg/crc/constants"
+	"github.com/code-ready/crc/pkg/crc/logging"
+	crcos "github.com/code-ready/crc/pkg/os"
+	"github.com/docker/go-units"
+)
+
+func diskCompactionSupported() error {
+	return nil
+}
+
+// enableDiskDiscard makes the guest discards free the blocks of the qcow2
+// images. The persistent domain definition is updated, the change is used
+// from the next start of the VM. It returns whether discard is enabled in
+// the running VM.
+func enableDiskDiscard(name string) (bool, error) {
+	liveXML, err := virsh("dumpxml", name)
+	if err != nil {
+		return false, err
+	}
+	active, err := hasDiskDiscard(liveXML)
+	if err != nil {
+		return false, err
+	}
+
+	xml, err := virsh("dumpxml", "--inactive", name)
+	if err != nil {
+		return active, err
+	}
+	updated, changed, err := addDiskDiscard(xml)
+	if err != nil || !changed {
+		return active, err
+	}
+	if err := defineDomain(updated); err != nil {
+		return active, err
+	}
+	logging.Info("Enabled discard on the VM disks, it is used from the next start of the VM")
+	return active, nil
+}
+
+// defineDomain replaces the persistent definition of a domain
+func defineDomain(xml string) error {
+	tmpFile, err := ioutil.TempFile("", "crc-domain")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.WriteString(xml); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	_, err = virsh("define", tmpFile.Name())
+	return err
+}
+
+// qcow2Driver is the driver element of a qcow2 disk of a domain XML
+// definition
+type qcow2Driver struct {
+	// offset is the position of the '<' of the element in the definition
+	offset  int64
+	discard bool
+}
+
+// qcow2Drivers lists the driver elements of the qcow2 disks of a domain XML
+// definition. The offsets of the elements are kept so that the definition
+// can be changed without encoding it again.
+func qcow2Drivers(domainXML string) ([]qcow2Driver, error) {
+	var drivers []qcow2Driver
+	var parents []string
+	decoder := xml.NewDecoder(strings.NewReader(domainXML))
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return drivers, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse the domain definition: %v", err)
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			if element.Name.Local == "driver" && len(parents) > 0 && parents[len(parents)-1] == "disk" {
+				driver := qcow2Driver{offset: offset}
+				isQcow2 := false
+				for _, attr := range element.Attr {
+					switch attr.Name.Local {
+					case "type":
+						isQcow2 = attr.Value == "qcow2"
+					case "discard":
+						driver.discard = true
+					}
+				}
+				if isQcow2 {
+					drivers = append(drivers, driver)
+				}
+			}
+			parents = append(parents, element.Name.Local)
+		case xml.EndElement:
+			if len(parents) > 0 {
+				parents = parents[:len(parents)-1]
+			}
+		}
+	}
+}
+
+// hasDiskDiscard checks if all the qcow2 disk drivers of a domain XML
+// definition have discard enabled
+func hasDiskDiscard(domainXML string) (bool, error) {
+	drivers, err := qcow2Drivers(domainXML)
+	if err != nil {
+		return false, err
+	}
+	for _, driver := range drivers {
+		if !driver.discard {
+			return false, nil
+		}
+	}
+	return len(drivers) > 0, nil
+}
+
+// addDiskDiscard adds discard='unmap' to the qcow2 disk drivers of a domain
+// XML definition, the data disk included
+func addDiskDiscard(domainXML string) (string, bool, error) {
+	drivers, err := qcow2Drivers(domainXML)
+	if err != nil {
+		return domainXML, false, err
+	}
+	const element = "<driver"
+	var updated strings.Builder
+	var copied int64
+	for _, driver := range drivers {
+		if driver.discard {
+			continue
+		}
+		insertAt := driver.offset + int64(len(element))
+		updated.WriteString(domainXML[copied:insertAt])
+		updated.WriteString(" discard='unmap'")
+		copied = insertAt
+	}
+	if copied == 0 {
+		return domainXML, false, nil
+	}
+	updated.WriteString(domainXML[copied:])
+	return updated.String(), true, nil
+}
+
+func compactDiskImage(name string) (int64, error) {
+	imagePath := filepath.Join(constants.GetInstanceDir(name), fmt.Sprintf("%s.qcow2", name))
+	info, err := os.Stat(imagePath)
+	if err != nil {
+		return 0, err
+	}
+	sizeBefore := allocatedSize(info)
+	// the compacted copy needs at most the space used by the image
+	if err := checkFreeSpace(filepath.Dir(imagePath), sizeBefore); err != nil {
+		return 0, err
+	}
+
+	args := []string{"convert", "-f", "qcow2", "-O", "qcow2"}
+	backingFile, err := getBackingFile(imagePath)
+	if err != nil {
+		return 0, err
+	}
+	if backingFile != "" {
+		args = append(args, "-B", backingFile)
+	}
+	compactPath := imagePath + ".compact"
+	defer os.Remove(compactPath)
+	if _, stderr, err := crcos.RunWithDefaultLocale("qemu-img", append(args, imagePath, compactPath)...); err != nil {
+		return 0, fmt.Errorf("Failed to compact the disk image: %v: %s", err, strings.TrimSpace(stderr))
+	}
+	if err := os.Chmod(compactPath, info.Mode()); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(compactPath, imagePath); err != nil {
+		return 0, err
+	}
+
+	info, err = os.Stat(imagePath)
+	if err != nil {
+		return 0, err
+	}
+	reclaimed := sizeBefore - allocatedSize(info)
+	if reclaimed < 0 {
+		return 0, nil
+	}
+	return reclaimed, nil
+}
+
+func checkFreeSpace(dir string, needed int64) error {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return err
+	}
+	available := int64(stat.Bavail) * int64(stat.Bsize)
+	if available < needed {
+		return fmt.Errorf("Not enough free space in %s to compact the disk image: %s are needed and %s are available",
+			dir, units.HumanSize(float64(needed)), units.HumanSize(float64(available)))
+	}
+	return nil
+}
+
+// allocatedSize returns the space used on disk by a sparse file
+func allocatedSize(info os.FileInfo) int64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return stat.Blocks * 512
+	}
+	return info.Size()
+}
+
+func getBackingFile(imagePath string) (string, error) {
+	stdout, stderr, err := crcos.RunWithDefaultLocale("qemu-img", "info", "--output=json", "-U", imagePath)
+	if err != nil {
+		return "", fmt.Errorf("Failed to get disk image information: %v: %s", err, strings.TrimSpace(stderr))
+	}
+	var info struct {
+		BackingFilename string `json:"backing-filename"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &info); err != nil {
+		return "", err
+	}
+	return info.BackingFilename, nil
+}
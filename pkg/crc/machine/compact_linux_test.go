@@ -0,0 +1,76 @@
+package machine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDiskDiscard(t *testing.T) {
+	xml := `<domain type='kvm'>
+  <devices>
+    <disk type='file' device='disk'>
+      <driver name='qemu' type='qcow2'/>
+      <source file='/home/user/.crc/machines/crc/crc.qcow2'/>
+      <target dev='vda' bus='virtio'/>
+    </disk>
+    <disk type='file' device='cdrom'>
+      <driver name='qemu' type='raw'/>
+    </disk>
+    <disk type='file' device='disk'>
+      <driver name='qemu' type='qcow2'/>
+      <source file='/home/user/.crc/machines/crc/data.qcow2'/>
+      <target dev='vdb' bus='virtio'/>
+    </disk>
+  </devices>
+</domain>`
+
+	updated, changed, err := addDiskDiscard(xml)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, `<domain type='kvm'>
+  <devices>
+    <disk type='file' device='disk'>
+      <driver discard='unmap' name='qemu' type='qcow2'/>
+      <source file='/home/user/.crc/machines/crc/crc.qcow2'/>
+      <target dev='vda' bus='virtio'/>
+    </disk>
+    <disk type='file' device='cdrom'>
+      <driver name='qemu' type='raw'/>
+    </disk>
+    <disk type='file' device='disk'>
+      <driver discard='unmap' name='qemu' type='qcow2'/>
+      <source file='/home/user/.crc/machines/crc/data.qcow2'/>
+      <target dev='vdb' bus='virtio'/>
+    </disk>
+  </devices>
+</domain>`, updated)
+
+	enabled, err := hasDiskDiscard(xml)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+	enabled, err = hasDiskDiscard(updated)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	_, changed, err = addDiskDiscard(updated)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	_, changed, err = addDiskDiscard(`<disk type='file' device='cdrom'><driver name='qemu' type='raw'/></disk>`)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	_, _, err = addDiskDiscard(`<disk><driver name='qemu' type='qcow2'/>`)
+	assert.Error(t, err)
+}
+
+func TestHasDiskDiscardWithOneDiskWithoutDiscard(t *testing.T) {
+	enabled, err := hasDiskDiscard(`<devices>
+  <disk><driver name='qemu' type='qcow2' discard='unmap'/></disk>
+  <disk><driver name='qemu' type='qcow2'/></disk>
+</devices>`)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+}
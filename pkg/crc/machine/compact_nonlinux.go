@@ -0,0 +1,20 @@
+// +build !linux
+
+package machine
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func diskCompactionSupported() error {
+	return fmt.Errorf("Compacting the disk is not supported on %s", runtime.GOOS)
+}
+
+func enableDiskDiscard(name string) (bool, error) {
+	return false, fmt.Errorf("Not implemented for %s", runtime.GOOS)
+}
+
+func compactDiskImage(name string) (int64, error) {
+	return 0, fmt.Errorf("Compacting the disk image is not supported on %s", runtime.GOOS)
+}
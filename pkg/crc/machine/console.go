@@ -27,8 +27,10 @@ func (client *client) GetConsoleURL() (*types.ConsoleResult, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "Error loading bundle metadata")
 	}
+	domains := client.domains()
+	crcBundleMetadata.UseDomains(domains.Cluster, domains.Apps)
 
-	clusterConfig, err := getClusterConfig(crcBundleMetadata)
+	clusterConfig, err := getClusterConfig(client.name, crcBundleMetadata)
 	if err != nil {
 		return nil, errors.Wrap(err, "Error loading cluster configuration")
 	}
@@ -0,0 +1,104 @@
+package machine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/code-ready/crc/pkg/crc/logging"
+	crcssh "github.com/code-ready/crc/pkg/crc/ssh"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// dataDiskSerial identifies the data disk in /dev/disk/by-id in the VM
+	dataDiskSerial = "crc-data"
+	// pvDataDir holds the directories of the hostpath persistent volumes
+	pvDataDir = "/mnt/pv-data"
+)
+
+// mountDataDisk formats the data disk when it is used for the first time and
+// mounts it over the directories of the hostpath persistent volumes
+func mountDataDisk(sshRunner *crcssh.Runner) error {
+	device := fmt.Sprintf("/dev/disk/by-id/virtio-%s", dataDiskSerial)
+	if _, _, err := sshRunner.RunPrivileged("Waiting for the data disk", "udevadm", "settle", "--exit-if-exists="+device); err != nil {
+		return err
+	}
+	if _, _, err := sshRunner.RunPrivileged("Checking for a filesystem on the data disk", "blkid", "-p", device); err != nil {
+		// blkid exits with 2 when the device has no filesystem
+		var exitErr *ssh.ExitError
+		if !errors.As(err, &exitErr) || exitErr.ExitStatus() != 2 {
+			return err
+		}
+		logging.Info("Formatting the data disk")
+		if _, _, err := sshRunner.RunPrivileged("Formatting the data disk", "mkfs.xfs", "-L", dataDiskSerial, device); err != nil {
+			return err
+		}
+	}
+
+	if _, _, err := sshRunner.Run("mountpoint", "-q", pvDataDir); err != nil {
+		// the persistent volume directories of the bundle are hidden by the mount, recreate them on the data disk
+		stdout, _, err := sshRunner.RunPrivileged("Listing the persistent volume directories",
+			"find", pvDataDir, "-maxdepth", "1", "-type", "d", "-printf", `'%m %u:%g %Z %p\n'`)
+		if err != nil {
+			return err
+		}
+		dirs, err := parsePVDirs(stdout)
+		if err != nil {
+			return err
+		}
+		if _, _, err := sshRunner.RunPrivileged("Mounting the data disk", "mount", device, pvDataDir); err != nil {
+			return err
+		}
+		for _, dir := range dirs {
+			if err := createPVDir(sshRunner, dir); err != nil {
+				return err
+			}
+		}
+	}
+
+	// this is a no-op unless data-disk-size was increased
+	_, _, err := sshRunner.RunPrivileged("Growing the data disk filesystem", "xfs_growfs", pvDataDir)
+	return err
+}
+
+// pvDir is a persistent volume directory of the bundle, the directories are
+// created on the data disk with the same mode, owner and SELinux context
+type pvDir struct {
+	path    string
+	mode    string
+	owner   string
+	context string
+}
+
+func parsePVDirs(findOutput string) ([]pvDir, error) {
+	var dirs []pvDir
+	for _, line := range strings.Split(strings.TrimSpace(findOutput), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("Unexpected persistent volume directory: %s", line)
+		}
+		dirs = append(dirs, pvDir{
+			mode:    fields[0],
+			owner:   fields[1],
+			context: fields[2],
+			path:    fields[3],
+		})
+	}
+	return dirs, nil
+}
+
+func createPVDir(sshRunner *crcssh.Runner, dir pvDir) error {
+	if _, _, err := sshRunner.RunPrivileged("Creating the persistent volume directories", "mkdir", "-p", "-m", dir.mode, dir.path); err != nil {
+		return err
+	}
+	// the mount point gets the mode of the filesystem root
+	if _, _, err := sshRunner.RunPrivileged("Setting the mode of the persistent volume directories", "chmod", dir.mode, dir.path); err != nil {
+		return err
+	}
+	if _, _, err := sshRunner.RunPrivileged("Setting the owner of the persistent volume directories", "chown", dir.owner, dir.path); err != nil {
+		return err
+	}
+	_, _, err := sshRunner.RunPrivileged("Setting the SELinux context of the persistent volume directories", "chcon", dir.context, dir.path)
+	return err
+}
@@ -0,0 +1,90 @@
+package machine
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/code-ready/crc/pkg/crc/constants"
+	"github.com/code-ready/crc/pkg/crc/logging"
+	crcos "github.com/code-ready/crc/pkg/os"
+)
+
+// attachDataDisk creates or grows the data disk image and adds it to the
+// persistent definition of the stopped VM
+func attachDataDisk(name string, size int) error {
+	path := constants.GetDataDiskPath(name)
+	currentSize, err := getVirtualSize(path)
+	switch {
+	case os.IsNotExist(err):
+		logging.Infof("Creating a %d GiB data disk in %s", size, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return err
+		}
+		if _, stderr, err := crcos.RunWithDefaultLocale("qemu-img", "create", "-f", "qcow2", path, fmt.Sprintf("%dG", size)); err != nil {
+			return fmt.Errorf("Failed to create the data disk: %v: %s", err, strings.TrimSpace(stderr))
+		}
+	case err != nil:
+		return err
+	case currentSize < gibToBytes(size):
+		logging.Infof("Growing the data disk to %d GiB", size)
+		if _, stderr, err := crcos.RunWithDefaultLocale("qemu-img", "resize", path, fmt.Sprintf("%dG", size)); err != nil {
+			return fmt.Errorf("Failed to grow the data disk: %v: %s", err, strings.TrimSpace(stderr))
+		}
+	case currentSize > gibToBytes(size):
+		logging.Warnf("The data disk cannot be shrunk, it will keep its size of %d GiB", currentSize/gibToBytes(1))
+	}
+
+	attached, err := isDataDiskAttached(name)
+	if err != nil || attached {
+		return err
+	}
+	_, err = virsh("attach-disk", name, path, "vdb", "--driver", "qemu", "--subdriver", "qcow2",
+		"--targetbus", "virtio", "--serial", dataDiskSerial, "--config")
+	return err
+}
+
+// detachDataDisk removes the data disk from the persistent definition of the
+// stopped VM, the image is kept so that its data is back when the data disk
+// is used again
+func detachDataDisk(name string) error {
+	attached, err := isDataDiskAttached(name)
+	if err != nil || !attached {
+		return err
+	}
+	path := constants.GetDataDiskPath(name)
+	logging.Infof("Detaching the data disk, its data is kept in %s", path)
+	_, err = virsh("detach-disk", name, path, "--config")
+	return err
+}
+
+func isDataDiskAttached(name string) (bool, error) {
+	xml, err := virsh("dumpxml", "--inactive", name)
+	if err != nil {
+		return false, err
+	}
+	return strings.Contains(xml, fmt.Sprintf("<source file='%s'/>", constants.GetDataDiskPath(name))), nil
+}
+
+func getVirtualSize(path string) (int64, error) {
+	if _, err := os.Stat(path); err != nil {
+		return 0, err
+	}
+	stdout, stderr, err := crcos.RunWithDefaultLocale("qemu-img", "info", "--output=json", "-U", path)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to get data disk information: %v: %s", err, strings.TrimSpace(stderr))
+	}
+	var info struct {
+		VirtualSize int64 `json:"virtual-size"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &info); err != nil {
+		return 0, err
+	}
+	return info.VirtualSize, nil
+}
+
+func gibToBytes(gib int) int64 {
+	return int64(gib) * 1024 * 1024 * 1024
+}
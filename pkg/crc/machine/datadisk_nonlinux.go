@@ -0,0 +1,16 @@
+// +build !linux
+
+package machine
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func attachDataDisk(name string, size int) error {
+	return fmt.Errorf("Data disks are not supported on %s", runtime.GOOS)
+}
+
+func detachDataDisk(name string) error {
+	return nil
+}
@@ -0,0 +1,24 @@
+package machine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePVDirs(t *testing.T) {
+	dirs, err := parsePVDirs(`755 root:root system_u:object_r:mnt_t:s0 /mnt/pv-data
+770 root:root system_u:object_r:container_file_t:s0 /mnt/pv-data/pv0001
+770 root:root system_u:object_r:container_file_t:s0 /mnt/pv-data/pv0002
+`)
+	require.NoError(t, err)
+	assert.Equal(t, []pvDir{
+		{path: "/mnt/pv-data", mode: "755", owner: "root:root", context: "system_u:object_r:mnt_t:s0"},
+		{path: "/mnt/pv-data/pv0001", mode: "770", owner: "root:root", context: "system_u:object_r:container_file_t:s0"},
+		{path: "/mnt/pv-data/pv0002", mode: "770", owner: "root:root", context: "system_u:object_r:container_file_t:s0"},
+	}, dirs)
+
+	_, err = parsePVDirs("770 /mnt/pv-data/pv0001")
+	assert.Error(t, err)
+}
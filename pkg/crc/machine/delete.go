@@ -6,6 +6,7 @@ import (
 )
 
 func (client *client) Delete() error {
+	client.closeStatusRunner()
 	libMachineAPIClient, cleanup := createLibMachineClient()
 	defer cleanup()
 	host, err := libMachineAPIClient.Load(client.name)
@@ -14,6 +15,8 @@ func (client *client) Delete() error {
 		return errors.Wrap(err, "Cannot load machine")
 	}
 
+	deleteAllSnapshots(client.name)
+
 	if err := host.Driver.Remove(); err != nil {
 		return errors.Wrap(err, "Driver cannot remove machine")
 	}
@@ -22,7 +25,17 @@ func (client *client) Delete() error {
 		return errors.Wrap(err, "Cannot remove machine")
 	}
 
-	if err := cleanKubeconfig(getGlobalKubeConfigPath(), getGlobalKubeConfigPath()); err != nil {
+	remaining, err := libMachineAPIClient.List()
+	if err != nil {
+		return errors.Wrap(err, "Cannot list machines")
+	}
+	if len(remaining) > 0 {
+		// other instances share the same cluster URL, only remove the contexts of this one
+		err = removeInstanceContexts(client.name, client.domains().Cluster, getGlobalKubeConfigPath(), getGlobalKubeConfigPath())
+	} else {
+		err = cleanKubeconfig(getGlobalKubeConfigPath(), getGlobalKubeConfigPath(), client.domains().Cluster)
+	}
+	if err != nil {
 		logging.Warn(err)
 	}
 	return nil
@@ -3,7 +3,11 @@ package fakemachine
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"time"
 
+	"github.com/code-ready/crc/pkg/crc/cluster"
 	"github.com/code-ready/crc/pkg/crc/machine/state"
 	"github.com/code-ready/crc/pkg/crc/machine/types"
 	"github.com/code-ready/crc/pkg/crc/network"
@@ -72,6 +76,44 @@ func (c *Client) GenerateBundle(forceStop bool) error {
 	return nil
 }
 
+func (c *Client) CompactDisk() (int64, error) {
+	if c.Failing {
+		return 0, errors.New("compact failed")
+	}
+	return 1_500_000_000, nil
+}
+
+func (c *Client) VMLogs(ctx context.Context, unit string, since time.Duration, follow bool, writer io.Writer) error {
+	if c.Failing {
+		return errors.New("logs failed")
+	}
+	_, err := fmt.Fprintf(writer, "%s started\n", unit)
+	return err
+}
+
+func (c *Client) CertsStatus() ([]cluster.CertExpiry, error) {
+	if c.Failing {
+		return nil, errors.New("certs status failed")
+	}
+	return []cluster.CertExpiry{
+		{
+			Name:     "kubelet client",
+			NotAfter: time.Date(2021, time.July, 12, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:  "ingress CA",
+			Error: "secret router-ca not found",
+		},
+	}, nil
+}
+
+func (c *Client) RenewCerts() error {
+	if c.Failing {
+		return errors.New("certs renewal failed")
+	}
+	return nil
+}
+
 func (c *Client) Start(ctx context.Context, startConfig types.StartConfig) (*types.StartResult, error) {
 	if c.Failing {
 		return nil, errors.New("Failed to start")
@@ -89,6 +131,27 @@ func (c *Client) Stop() (state.State, error) {
 	return state.Stopped, nil
 }
 
+func (c *Client) Pause() error {
+	if c.Failing {
+		return errors.New("pause failed")
+	}
+	return nil
+}
+
+func (c *Client) Resume() error {
+	if c.Failing {
+		return errors.New("resume failed")
+	}
+	return nil
+}
+
+func (c *Client) Resize(memory, cpus int) error {
+	if c.Failing {
+		return errors.New("resize failed")
+	}
+	return nil
+}
+
 func (c *Client) Status() (*types.ClusterStatusResult, error) {
 	if c.Failing {
 		return nil, errors.New("broken")
@@ -99,6 +162,26 @@ func (c *Client) Status() (*types.ClusterStatusResult, error) {
 		OpenshiftVersion: "4.5.1",
 		DiskUse:          10_000_000_000,
 		DiskSize:         20_000_000_000,
+		RAMUse:           6_000_000_000,
+		RAMSize:          9_000_000_000,
+		LoadAverage:      []float64{1.52, 0.98, 0.61},
+		Pods:             62,
+		Containers:       81,
+		NameServers:      []string{"10.88.0.8", "192.168.130.1"},
+		SearchDomains:    []string{"crc.testing"},
+		Operators: []cluster.OperatorStatus{
+			{
+				Name:               "authentication",
+				Available:          true,
+				LastTransitionTime: time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC),
+			},
+			{
+				Name:               "ingress",
+				Available:          true,
+				Message:            "desired and current number of IngressControllers are equal",
+				LastTransitionTime: time.Date(2021, 6, 1, 10, 30, 0, 0, time.UTC),
+			},
+		},
 	}, nil
 }
 
@@ -109,3 +192,36 @@ func (c *Client) Exists() (bool, error) {
 func (c *Client) IsRunning() (bool, error) {
 	return true, nil
 }
+
+func (c *Client) SaveSnapshot(snapshot string) error {
+	if c.Failing {
+		return errors.New("snapshot save failed")
+	}
+	return nil
+}
+
+func (c *Client) ListSnapshots() ([]types.SnapshotDetails, error) {
+	if c.Failing {
+		return nil, errors.New("snapshot list failed")
+	}
+	return []types.SnapshotDetails{
+		{
+			Name:         "fresh",
+			CreationTime: time.Date(2021, time.June, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}, nil
+}
+
+func (c *Client) RestoreSnapshot(snapshot string) error {
+	if c.Failing {
+		return errors.New("snapshot restore failed")
+	}
+	return nil
+}
+
+func (c *Client) DeleteSnapshot(snapshot string) error {
+	if c.Failing {
+		return errors.New("snapshot delete failed")
+	}
+	return nil
+}
@@ -70,7 +70,7 @@ func (client *client) GenerateBundle(forceStop bool) error {
 		return err
 	}
 
-	if err := copier.CopyPrivateSSHKey(constants.GetPrivateKeyPath()); err != nil {
+	if err := copier.CopyPrivateSSHKey(constants.GetPrivateKeyPath(client.name)); err != nil {
 		return err
 	}
 
@@ -81,7 +81,7 @@ func (client *client) GenerateBundle(forceStop bool) error {
 	// Copy disk image
 	logging.Infof("Copying the disk image to %s", customBundleNameWithoutExtension)
 	logging.Debugf("Absolute path of custom bundle directory: %s", customBundleDir)
-	diskPath, diskFormat, err := copyDiskImage(customBundleDir)
+	diskPath, diskFormat, err := copyDiskImage(client.name, customBundleDir)
 	if err != nil {
 		return err
 	}
@@ -128,7 +128,7 @@ func loadVM(client *client) (*bundle.CrcBundleInfo, *crcssh.Runner, error) {
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "Error getting the IP")
 	}
-	sshRunner, err := crcssh.CreateRunner(instanceIP, getSSHPort(client.useVSock()), crcBundleMetadata.GetSSHKeyPath(), constants.GetPrivateKeyPath(), constants.GetRsaPrivateKeyPath())
+	sshRunner, err := crcssh.CreateRunner(instanceIP, getSSHPort(client.useVSock()), crcBundleMetadata.GetSSHKeyPath(), constants.GetPrivateKeyPath(client.name), constants.GetRsaPrivateKeyPath(client.name))
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "Error creating the ssh client")
 	}
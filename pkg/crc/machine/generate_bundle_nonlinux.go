@@ -7,6 +7,6 @@ import (
 	"runtime"
 )
 
-func copyDiskImage(dirName string) (string, string, error) {
+func copyDiskImage(name, dirName string) (string, string, error) {
 	return "", "", fmt.Errorf("Not implemented for %s", runtime.GOOS)
 }
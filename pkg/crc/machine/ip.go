@@ -27,6 +27,6 @@ func (client *client) ConnectionDetails() (*types.ConnectionDetails, error) {
 		IP:          ip,
 		SSHPort:     getSSHPort(client.useVSock()),
 		SSHUsername: constants.DefaultSSHUser,
-		SSHKeys:     []string{constants.GetPrivateKeyPath(), constants.GetRsaPrivateKeyPath(), bundle.GetSSHKeyPath()},
+		SSHKeys:     []string{constants.GetPrivateKeyPath(client.name), constants.GetRsaPrivateKeyPath(client.name), bundle.GetSSHKeyPath()},
 	}, nil
 }
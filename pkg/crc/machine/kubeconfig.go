@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
@@ -22,10 +23,45 @@ import (
 	"k8s.io/client-go/tools/clientcmd/api"
 )
 
-const (
-	adminContext     = "crc-admin"
-	developerContext = "crc-developer"
-)
+func adminContext(name string) string {
+	return fmt.Sprintf("%s-admin", name)
+}
+
+func developerContext(name string) string {
+	return fmt.Sprintf("%s-developer", name)
+}
+
+// authInfoName returns the name of the kubeconfig user entry holding the token of username.
+// The default instance keeps the plain username for backward compatibility.
+func authInfoName(name, username string) string {
+	if name == constants.DefaultName {
+		return username
+	}
+	return fmt.Sprintf("%s/%s", username, name)
+}
+
+// isInstanceContext returns true when the context uses one of the clusters crc
+// wrote, the contexts of the user with the same name are left alone
+func isInstanceContext(cfg *api.Config, contextName string, clusterNames []string) bool {
+	context, ok := cfg.Contexts[contextName]
+	return ok && contains(clusterNames, context.Cluster)
+}
+
+// instanceClusterNames returns the names of the clusters using the default
+// cluster URL, or the URLs of the clusterDomains
+func instanceClusterNames(cfg *api.Config, clusterDomains ...string) []string {
+	servers := []string{fmt.Sprintf("https://api%s:6443", constants.ClusterDomain)}
+	for _, domain := range clusterDomains {
+		servers = append(servers, fmt.Sprintf("https://api.%s:6443", domain))
+	}
+	var clusterNames []string
+	for name, cluster := range cfg.Clusters {
+		if contains(servers, cluster.Server) {
+			clusterNames = append(clusterNames, name)
+		}
+	}
+	return clusterNames
+}
 
 func updateClientCrtAndKeyToKubeconfig(clientKey, clientCrt []byte, srcKubeconfigPath, destKubeconfigPath string) error {
 	cfg, err := clientcmd.LoadFromFile(srcKubeconfigPath)
@@ -46,7 +82,10 @@ func updateClientCrtAndKeyToKubeconfig(clientKey, clientCrt []byte, srcKubeconfi
 	return clientcmd.WriteToFile(*cfg, destKubeconfigPath)
 }
 
-func writeKubeconfig(ip string, clusterConfig *types.ClusterConfig) error {
+// writeKubeconfig adds the contexts of the instance to the kubeconfig of the user.
+// tlsServerName is the hostname the API server certificate is valid for, it
+// differs from the hostname of the cluster URL when a custom cluster-domain is used.
+func writeKubeconfig(name, ip string, clusterConfig *types.ClusterConfig, tlsServerName string) error {
 	kubeconfig := getGlobalKubeConfigPath()
 	dir := filepath.Dir(kubeconfig)
 	if err := os.MkdirAll(dir, 0755); err != nil {
@@ -63,6 +102,22 @@ func writeKubeconfig(ip string, clusterConfig *types.ClusterConfig) error {
 	if err != nil {
 		return err
 	}
+	apiURL, err := url.Parse(clusterConfig.ClusterAPI)
+	if err != nil {
+		return err
+	}
+	if tlsServerName == apiURL.Hostname() {
+		tlsServerName = ""
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(ca) {
+		return fmt.Errorf("failed to parse root certificate")
+	}
+	// the routes of a custom apps-domain, the OAuth server included, use a certificate signed by this CA
+	if ingressCA, err := ioutil.ReadFile(constants.GetIngressCAPath(name)); err == nil {
+		roots.AppendCertsFromPEM(ingressCA)
+	}
 
 	cfg, err := clientcmd.LoadFromFile(kubeconfig)
 	if err != nil {
@@ -71,17 +126,23 @@ func writeKubeconfig(ip string, clusterConfig *types.ClusterConfig) error {
 	cfg.Clusters[host] = &api.Cluster{
 		Server:                   clusterConfig.ClusterAPI,
 		CertificateAuthorityData: ca,
+		TLSServerName:            tlsServerName,
 	}
 
-	if err := addContext(cfg, ip, clusterConfig, ca, adminContext, "kubeadmin", clusterConfig.KubeAdminPass); err != nil {
+	tlsConfig := tokenRequestTLSConfig(apiURL.Hostname(), tlsServerName, roots)
+	if err := addContext(cfg, ip, clusterConfig, tlsConfig, adminContext(name), authInfoName(name, "kubeadmin"), "kubeadmin", clusterConfig.KubeAdminPass); err != nil {
 		return err
 	}
-	if err := addContext(cfg, ip, clusterConfig, ca, developerContext, "developer", "developer"); err != nil {
+	if err := addContext(cfg, ip, clusterConfig, tlsConfig, developerContext(name), authInfoName(name, "developer"), "developer", "developer"); err != nil {
 		return err
 	}
 
 	if cfg.CurrentContext == "" {
-		cfg.CurrentContext = adminContext
+		cfg.CurrentContext = adminContext(name)
+	} else if isInstanceContext(cfg, cfg.CurrentContext, []string{host}) &&
+		cfg.CurrentContext != adminContext(name) && cfg.CurrentContext != developerContext(name) {
+		// the current context belongs to another instance, its token is not valid for this one
+		cfg.CurrentContext = adminContext(name)
 	}
 
 	return clientcmd.WriteToFile(*cfg, kubeconfig)
@@ -122,23 +183,50 @@ func hostname(clusterAPI string) (string, error) {
 	return strings.ReplaceAll(h, ".", "-"), nil
 }
 
-func addContext(cfg *api.Config, ip string, clusterConfig *types.ClusterConfig, ca []byte, context, username, password string) error {
+// tokenRequestTLSConfig returns the TLS configuration used to request the tokens.
+// The connections to apiHost verify the certificate against tlsServerName when it
+// is set, the connections to the OAuth server against the hostname of its route.
+func tokenRequestTLSConfig(apiHost, tlsServerName string, roots *x509.CertPool) *tls.Config {
+	tlsConfig := &tls.Config{
+		RootCAs:    roots,
+		MinVersion: tls.VersionTLS12,
+	}
+	if tlsServerName == "" {
+		return tlsConfig
+	}
+	// the standard verification is done in VerifyConnection with the right hostname
+	tlsConfig.InsecureSkipVerify = true // #nosec G402
+	tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+		serverName := state.ServerName
+		if serverName == apiHost {
+			serverName = tlsServerName
+		}
+		if len(state.PeerCertificates) == 0 {
+			return fmt.Errorf("no certificate presented by %s", state.ServerName)
+		}
+		intermediates := x509.NewCertPool()
+		for _, cert := range state.PeerCertificates[1:] {
+			intermediates.AddCert(cert)
+		}
+		_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+			DNSName:       serverName,
+			Roots:         roots,
+			Intermediates: intermediates,
+		})
+		return err
+	}
+	return tlsConfig
+}
+
+func addContext(cfg *api.Config, ip string, clusterConfig *types.ClusterConfig, tlsConfig *tls.Config, context, authInfo, username, password string) error {
 	host, err := hostname(clusterConfig.ClusterAPI)
 	if err != nil {
 		return err
 	}
-	roots := x509.NewCertPool()
-	ok := roots.AppendCertsFromPEM(ca)
-	if !ok {
-		return fmt.Errorf("failed to parse root certificate")
-	}
 	token, err := tokencmd.RequestToken(&restclient.Config{
 		Host: clusterConfig.ClusterAPI,
 		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs:    roots,
-				MinVersion: tls.VersionTLS12,
-			},
+			TLSClientConfig: tlsConfig,
 			DialContext: func(ctx gocontext.Context, network, address string) (net.Conn, error) {
 				port := strings.SplitN(address, ":", 2)[1]
 				dialer := net.Dialer{
@@ -152,12 +240,12 @@ func addContext(cfg *api.Config, ip string, clusterConfig *types.ClusterConfig,
 	if err != nil {
 		return err
 	}
-	cfg.AuthInfos[username] = &api.AuthInfo{
+	cfg.AuthInfos[authInfo] = &api.AuthInfo{
 		Token: token,
 	}
 	cfg.Contexts[context] = &api.Context{
 		Cluster:   host,
-		AuthInfo:  username,
+		AuthInfo:  authInfo,
 		Namespace: "default",
 	}
 	return nil
@@ -175,18 +263,15 @@ func getGlobalKubeConfigPath() string {
 	return filepath.Join(constants.GetHomeDir(), ".kube", "config")
 }
 
-func cleanKubeconfig(input, output string) error {
+// cleanKubeconfig removes the clusters of the default cluster URL, or of the
+// URLs of the clusterDomains, and their contexts and users
+func cleanKubeconfig(input, output string, clusterDomains ...string) error {
 	cfg, err := clientcmd.LoadFromFile(input)
 	if err != nil {
 		return err
 	}
 
-	var clusterNames []string
-	for name, cluster := range cfg.Clusters {
-		if cluster.Server == fmt.Sprintf("https://api%s:6443", constants.ClusterDomain) {
-			clusterNames = append(clusterNames, name)
-		}
-	}
+	clusterNames := instanceClusterNames(cfg, clusterDomains...)
 	var contextNames []string
 	authNames := make(map[string]struct{})
 	for name, context := range cfg.Contexts {
@@ -218,6 +303,45 @@ func cleanKubeconfig(input, output string) error {
 	return clientcmd.WriteToFile(*cfg, output)
 }
 
+// removeInstanceContexts only removes the contexts created for the named instance, and
+// their users if they are not shared with other contexts. It is used instead of
+// cleanKubeconfig when other instances using the same cluster URL still exist.
+// The contexts with the same names using other clusters are kept.
+func removeInstanceContexts(name, clusterDomain, input, output string) error {
+	cfg, err := clientcmd.LoadFromFile(input)
+	if err != nil {
+		return err
+	}
+
+	clusterNames := instanceClusterNames(cfg, clusterDomain)
+	var contextNames []string
+	authNames := make(map[string]struct{})
+	for _, contextName := range []string{adminContext(name), developerContext(name)} {
+		if isInstanceContext(cfg, contextName, clusterNames) {
+			contextNames = append(contextNames, contextName)
+			authNames[cfg.Contexts[contextName].AuthInfo] = struct{}{}
+		}
+	}
+	// keep auth if it is shared with other contexts
+	for contextName, context := range cfg.Contexts {
+		if !contains(contextNames, contextName) {
+			delete(authNames, context.AuthInfo)
+		}
+	}
+
+	for _, contextName := range contextNames {
+		delete(cfg.Contexts, contextName)
+		if cfg.CurrentContext == contextName {
+			cfg.CurrentContext = ""
+		}
+	}
+	for authName := range authNames {
+		delete(cfg.AuthInfos, authName)
+	}
+
+	return clientcmd.WriteToFile(*cfg, output)
+}
+
 func contains(arr []string, str string) bool {
 	for _, a := range arr {
 		if a == str {
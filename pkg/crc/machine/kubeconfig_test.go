@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 var dummyKubeconfigFileContent = `apiVersion: v1
@@ -53,6 +54,42 @@ func TestCleanKubeconfig(t *testing.T) {
 	assert.YAMLEq(t, string(expected), string(actual))
 }
 
+func TestRemoveInstanceContexts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clean")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, removeInstanceContexts("crc", "", filepath.Join("testdata", "kubeconfig.in"), filepath.Join(dir, "kubeconfig")))
+	cfg, err := clientcmd.LoadFromFile(filepath.Join(dir, "kubeconfig"))
+	assert.NoError(t, err)
+
+	assert.NotContains(t, cfg.Contexts, "crc-admin")
+	assert.NotContains(t, cfg.Contexts, "crc-developer")
+	assert.Contains(t, cfg.Contexts, "project1/api-crc-testing:6443/developer")
+	assert.NotContains(t, cfg.AuthInfos, "kubeadmin")
+	assert.Contains(t, cfg.AuthInfos, "developer")
+	assert.Contains(t, cfg.Clusters, "api-crc-testing:6443")
+	assert.Equal(t, "project1/api-crc-testing:6443/developer", cfg.CurrentContext)
+}
+
+func TestRemoveInstanceContextsKeepsOtherClusters(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clean")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg, err := clientcmd.LoadFromFile(filepath.Join("testdata", "kubeconfig.in"))
+	assert.NoError(t, err)
+	cfg.Contexts["kind-admin"] = cfg.Contexts["kind-reference"]
+	assert.NoError(t, clientcmd.WriteToFile(*cfg, filepath.Join(dir, "kubeconfig")))
+
+	assert.NoError(t, removeInstanceContexts("kind", "", filepath.Join(dir, "kubeconfig"), filepath.Join(dir, "kubeconfig")))
+	cfg, err = clientcmd.LoadFromFile(filepath.Join(dir, "kubeconfig"))
+	assert.NoError(t, err)
+
+	assert.Contains(t, cfg.Contexts, "kind-admin")
+	assert.Contains(t, cfg.AuthInfos, "kind-reference")
+}
+
 func TestUpdateUserCaAndKeyToKubeconfig(t *testing.T) {
 	f, err := ioutil.TempFile("", "kubeconfig")
 	assert.NoError(t, err, "")
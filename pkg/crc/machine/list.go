@@ -0,0 +1,83 @@
+package machine
+
+import (
+	"fmt"
+
+	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/machine/state"
+	"github.com/code-ready/crc/pkg/crc/machine/types"
+	"github.com/code-ready/crc/pkg/libmachine"
+	libmachinestate "github.com/code-ready/machine/libmachine/state"
+	"github.com/pkg/errors"
+)
+
+// ListInstances returns the details of all the instances which exist,
+// whatever their state is
+func ListInstances() ([]types.InstanceDetails, error) {
+	libMachineAPIClient, cleanup := createLibMachineClient()
+	defer cleanup()
+
+	names, err := libMachineAPIClient.List()
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list machines")
+	}
+
+	instances := []types.InstanceDetails{}
+	for _, name := range names {
+		instances = append(instances, getInstanceDetails(libMachineAPIClient, name))
+	}
+	return instances, nil
+}
+
+func getInstanceDetails(api libmachine.API, name string) types.InstanceDetails {
+	details := types.InstanceDetails{
+		Name:  name,
+		State: state.Error,
+	}
+	host, err := api.Load(name)
+	if err != nil {
+		logging.Debugf("Cannot load machine %s: %v", name, err)
+		return details
+	}
+	if vmState, err := host.Driver.GetState(); err != nil {
+		logging.Debugf("Cannot get state of machine %s: %v", name, err)
+	} else {
+		details.State = state.FromMachine(vmState)
+	}
+	if bundleInfo, err := getBundleMetadataFromDriver(host.Driver); err != nil {
+		logging.Debugf("Cannot load bundle metadata of machine %s: %v", name, err)
+	} else {
+		details.OpenshiftVersion = bundleInfo.GetOpenshiftVersion()
+		details.Bundle = bundleInfo.GetBundleName()
+	}
+	return details
+}
+
+// ensureNoOtherInstanceRunning returns an error when an instance other than
+// the named one is running. All instances share the same IP address, ports
+// and domain names, so only one of them can run at a time.
+func ensureNoOtherInstanceRunning(api libmachine.API, name string) error {
+	names, err := api.List()
+	if err != nil {
+		return errors.Wrap(err, "Cannot list machines")
+	}
+	for _, other := range names {
+		if other == name {
+			continue
+		}
+		host, err := api.Load(other)
+		if err != nil {
+			logging.Debugf("Cannot load machine %s: %v", other, err)
+			continue
+		}
+		vmState, err := host.Driver.GetState()
+		if err != nil {
+			logging.Debugf("Cannot get state of machine %s: %v", other, err)
+			continue
+		}
+		if vmState == libmachinestate.Running || vmState == libmachinestate.Paused {
+			return fmt.Errorf("Cannot start instance '%s' while instance '%s' is running, they use the same IP address and DNS names: stop it with 'crc stop --instance %s' first", name, other, other)
+		}
+	}
+	return nil
+}
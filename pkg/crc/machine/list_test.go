@@ -0,0 +1,50 @@
+package machine
+
+import (
+	"testing"
+
+	"github.com/code-ready/crc/pkg/libmachine"
+	"github.com/code-ready/crc/pkg/libmachine/host"
+	"github.com/code-ready/machine/libmachine/drivers"
+	"github.com/code-ready/machine/libmachine/state"
+	"github.com/stretchr/testify/assert"
+)
+
+type stateDriver struct {
+	drivers.Driver
+	state state.State
+}
+
+func (d *stateDriver) GetState() (state.State, error) {
+	return d.state, nil
+}
+
+type instancesAPI struct {
+	libmachine.API
+	states map[string]state.State
+}
+
+func (api *instancesAPI) List() ([]string, error) {
+	var names []string
+	for name := range api.states {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (api *instancesAPI) Load(name string) (*host.Host, error) {
+	return &host.Host{Name: name, Driver: &stateDriver{state: api.states[name]}}, nil
+}
+
+func TestEnsureNoOtherInstanceRunning(t *testing.T) {
+	api := &instancesAPI{states: map[string]state.State{
+		"crc":  state.Stopped,
+		"test": state.Stopped,
+	}}
+	assert.NoError(t, ensureNoOtherInstanceRunning(api, "crc"))
+
+	api.states["crc"] = state.Running
+	assert.NoError(t, ensureNoOtherInstanceRunning(api, "crc"))
+	assert.EqualError(t, ensureNoOtherInstanceRunning(api, "test"),
+		"Cannot start instance 'test' while instance 'crc' is running, they use the same IP address and DNS names: stop it with 'crc stop --instance crc' first")
+}
@@ -0,0 +1,88 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/systemd"
+)
+
+// dnsmasqUnit is not a systemd unit, the DNS server runs in a podman container
+const dnsmasqUnit = "dnsmasq"
+
+var validUnitName = regexp.MustCompile(`^[A-Za-z0-9@._-]+$`)
+
+// VMLogs streams the journal of a unit of the VM. With follow, the new entries
+// are written until the context is cancelled.
+func (client *client) VMLogs(ctx context.Context, unit string, since time.Duration, follow bool, writer io.Writer) error {
+	if !validUnitName.MatchString(unit) {
+		return fmt.Errorf("Invalid unit name: %s", unit)
+	}
+	_, sshRunner, err := loadVM(client)
+	if err != nil {
+		return err
+	}
+	defer sshRunner.Close()
+
+	if unit == dnsmasqUnit {
+		if _, _, err := sshRunner.RunPrivileged("Checking the dnsmasq container", "podman", "container", "exists", dnsmasqUnit); err != nil {
+			return client.noDnsmasqError()
+		}
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			// interrupts the command
+			sshRunner.Close()
+		case <-done:
+		}
+	}()
+	writer = &lockedWriter{writer: writer}
+	command := append([]string{"sudo"}, vmLogsCommand(unit, since, follow)...)
+	err = sshRunner.Stream(strings.Join(command, " "), nil, writer, writer)
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+func (client *client) noDnsmasqError() error {
+	if client.useVSock() {
+		return fmt.Errorf("There is no dnsmasq container in the VM with the user mode network, the DNS queries are answered by the crc daemon on the host")
+	}
+	return fmt.Errorf("There is no dnsmasq container in the VM, the DNS queries are sent to the configured nameservers")
+}
+
+func vmLogsCommand(unit string, since time.Duration, follow bool) []string {
+	if unit != dnsmasqUnit {
+		return systemd.JournalCommand(unit, since, follow)
+	}
+	args := []string{"podman", "logs"}
+	if since > 0 {
+		args = append(args, "--since", since.String())
+	}
+	if follow {
+		args = append(args, "--follow")
+	}
+	return append(args, dnsmasqUnit)
+}
+
+// lockedWriter serializes the writes of the stdout and stderr of a command
+type lockedWriter struct {
+	lock   sync.Mutex
+	writer io.Writer
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.writer.Write(p)
+}
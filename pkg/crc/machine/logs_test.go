@@ -0,0 +1,14 @@
+package machine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVMLogsCommand(t *testing.T) {
+	assert.Equal(t, []string{"journalctl", "--unit", "crio", "--no-pager", "--follow"}, vmLogsCommand("crio", 0, true))
+	assert.Equal(t, []string{"podman", "logs", "--since", "10m0s", "--follow", "dnsmasq"}, vmLogsCommand("dnsmasq", 10*time.Minute, true))
+	assert.Equal(t, []string{"podman", "logs", "dnsmasq"}, vmLogsCommand("dnsmasq", 0, false))
+}
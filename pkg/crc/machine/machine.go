@@ -14,12 +14,12 @@ import (
 	"github.com/code-ready/machine/libmachine/drivers"
 )
 
-func getClusterConfig(bundleInfo *bundle.CrcBundleInfo) (*types.ClusterConfig, error) {
-	kubeadminPassword, err := cluster.GetKubeadminPassword()
+func getClusterConfig(name string, bundleInfo *bundle.CrcBundleInfo) (*types.ClusterConfig, error) {
+	kubeadminPassword, err := cluster.GetKubeadminPassword(name)
 	if err != nil {
 		return nil, fmt.Errorf("Error reading kubeadmin password from bundle %v", err)
 	}
-	proxyConfig, err := getProxyConfig(bundleInfo.ClusterInfo.BaseDomain)
+	proxyConfig, err := getProxyConfig(bundleInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -72,13 +72,16 @@ func getIP(h *host.Host, vsockNetwork bool) (string, error) {
 	return h.Driver.GetIP()
 }
 
-func getProxyConfig(baseDomainName string) (*network.ProxyConfig, error) {
+func getProxyConfig(bundleInfo *bundle.CrcBundleInfo) (*network.ProxyConfig, error) {
 	proxy, err := network.NewProxyConfig()
 	if err != nil {
 		return nil, err
 	}
 	if proxy.IsEnabled() {
-		proxy.AddNoProxy(fmt.Sprintf(".%s", baseDomainName))
+		proxy.AddNoProxy(fmt.Sprintf(".%s", bundleInfo.ClusterInfo.BaseDomain))
+		if bundleInfo.HasCustomDomains() {
+			proxy.AddNoProxy(fmt.Sprintf(".%s", bundleInfo.GetClusterDomain()), fmt.Sprintf(".%s", bundleInfo.GetAppsDomain()))
+		}
 	}
 
 	return proxy, nil
@@ -0,0 +1,77 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/constants"
+	"github.com/code-ready/crc/pkg/crc/logging"
+	crcssh "github.com/code-ready/crc/pkg/crc/ssh"
+	libmachinestate "github.com/code-ready/machine/libmachine/state"
+	"github.com/pkg/errors"
+)
+
+// Pause suspends the running VM, its memory is kept so that OpenShift does
+// not need to start again on resume
+func (client *client) Pause() error {
+	libMachineAPIClient, cleanup := createLibMachineClient()
+	defer cleanup()
+
+	host, err := libMachineAPIClient.Load(client.name)
+	if err != nil {
+		return errors.Wrap(err, "Cannot load machine")
+	}
+	vmState, err := host.Driver.GetState()
+	if err != nil {
+		return errors.Wrap(err, "Cannot get machine state")
+	}
+	if vmState != libmachinestate.Running {
+		return fmt.Errorf("Cannot pause the CodeReady Containers VM, it is %s", vmState)
+	}
+
+	logging.Info("Pausing the CodeReady Containers VM...")
+	return suspendVM(client.name)
+}
+
+func (client *client) Resume() error {
+	libMachineAPIClient, cleanup := createLibMachineClient()
+	defer cleanup()
+
+	host, err := libMachineAPIClient.Load(client.name)
+	if err != nil {
+		return errors.Wrap(err, "Cannot load machine")
+	}
+	vmState, err := host.Driver.GetState()
+	if err != nil {
+		return errors.Wrap(err, "Cannot get machine state")
+	}
+	if vmState != libmachinestate.Paused {
+		return fmt.Errorf("Cannot resume the CodeReady Containers VM, it is %s", vmState)
+	}
+
+	logging.Info("Resuming the CodeReady Containers VM...")
+	if err := resumeVM(client.name); err != nil {
+		return err
+	}
+
+	bundle, err := getBundleMetadataFromDriver(host.Driver)
+	if err != nil {
+		return errors.Wrap(err, "Error loading bundle metadata")
+	}
+	instanceIP, err := getIP(host, client.useVSock())
+	if err != nil {
+		return errors.Wrap(err, "Error getting the IP")
+	}
+	sshRunner, err := crcssh.CreateRunner(instanceIP, getSSHPort(client.useVSock()), bundle.GetSSHKeyPath(), constants.GetPrivateKeyPath(client.name), constants.GetRsaPrivateKeyPath(client.name))
+	if err != nil {
+		return errors.Wrap(err, "Error creating the ssh client")
+	}
+	defer sshRunner.Close()
+
+	if err := sshRunner.WaitForConnectivity(context.Background(), 30*time.Second); err != nil {
+		return errors.Wrap(err, "Failed to connect to the CRC VM with SSH -- host might be unreachable")
+	}
+	// the guest clock stood still while the VM was paused
+	return syncClockWithHost(sshRunner)
+}
@@ -0,0 +1,14 @@
+package machine
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func suspendVM(name string) error {
+	return fmt.Errorf("Pausing the VM is not supported on %s", runtime.GOOS)
+}
+
+func resumeVM(name string) error {
+	return fmt.Errorf("Resuming the VM is not supported on %s", runtime.GOOS)
+}
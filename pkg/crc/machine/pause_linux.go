@@ -0,0 +1,17 @@
+package machine
+
+import "fmt"
+
+func suspendVM(name string) error {
+	if _, err := virsh("suspend", name); err != nil {
+		return fmt.Errorf("Failed to pause the VM: %v", err)
+	}
+	return nil
+}
+
+func resumeVM(name string) error {
+	if _, err := virsh("resume", name); err != nil {
+		return fmt.Errorf("Failed to resume the VM: %v", err)
+	}
+	return nil
+}
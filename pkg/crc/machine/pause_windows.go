@@ -0,0 +1,21 @@
+package machine
+
+import (
+	"fmt"
+
+	"github.com/code-ready/crc/pkg/os/windows/powershell"
+)
+
+func suspendVM(name string) error {
+	if _, stderr, err := powershell.Execute("Hyper-V\\Suspend-VM", "-Name", name); err != nil {
+		return fmt.Errorf("Failed to pause the VM: %v: %s", err, stderr)
+	}
+	return nil
+}
+
+func resumeVM(name string) error {
+	if _, stderr, err := powershell.Execute("Hyper-V\\Resume-VM", "-Name", name); err != nil {
+		return fmt.Errorf("Failed to resume the VM: %v: %s", err, stderr)
+	}
+	return nil
+}
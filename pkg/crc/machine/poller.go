@@ -0,0 +1,126 @@
+package machine
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/machine/types"
+)
+
+// StatusUpdate is the result of a Status call made by a StatusPoller
+type StatusUpdate struct {
+	Status *types.ClusterStatusResult
+	Err    error
+}
+
+func (update StatusUpdate) equal(other StatusUpdate) bool {
+	if (update.Err == nil) != (other.Err == nil) {
+		return false
+	}
+	if update.Err != nil && update.Err.Error() != other.Err.Error() {
+		return false
+	}
+	return reflect.DeepEqual(update.Status, other.Status)
+}
+
+// StatusPoller calls Status at a fixed interval once for all its subscribers,
+// and only while there is at least one of them. A subscriber receives the
+// last status, then each status which differs from the previous one.
+type StatusPoller struct {
+	client   Client
+	interval time.Duration
+
+	lock        sync.Mutex
+	subscribers map[chan StatusUpdate]struct{}
+	last        *StatusUpdate
+	polling     bool
+	wakeup      chan struct{}
+}
+
+func NewStatusPoller(client Client, interval time.Duration) *StatusPoller {
+	return &StatusPoller{
+		client:      client,
+		interval:    interval,
+		subscribers: make(map[chan StatusUpdate]struct{}),
+		wakeup:      make(chan struct{}, 1),
+	}
+}
+
+// Subscribe returns the channel of the status updates and the function which
+// closes it
+func (p *StatusPoller) Subscribe() (<-chan StatusUpdate, func()) {
+	// a single slot, a slow subscriber only gets the latest update
+	ch := make(chan StatusUpdate, 1)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.last != nil {
+		ch <- *p.last
+	}
+	p.subscribers[ch] = struct{}{}
+	if !p.polling {
+		p.polling = true
+		go p.poll()
+	}
+
+	return ch, func() {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+		delete(p.subscribers, ch)
+		close(ch)
+	}
+}
+
+// Refresh makes the poller call Status without waiting for the interval
+func (p *StatusPoller) Refresh() {
+	select {
+	case p.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+func (p *StatusPoller) poll() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		status, err := p.client.Status()
+		p.publish(StatusUpdate{Status: status, Err: err})
+
+		select {
+		case <-ticker.C:
+		case <-p.wakeup:
+		}
+
+		p.lock.Lock()
+		if len(p.subscribers) == 0 {
+			p.polling = false
+			// the next subscriber must not get an outdated status
+			p.last = nil
+			p.lock.Unlock()
+			return
+		}
+		p.lock.Unlock()
+	}
+}
+
+func (p *StatusPoller) publish(update StatusUpdate) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.last != nil && p.last.equal(update) {
+		return
+	}
+	p.last = &update
+	for ch := range p.subscribers {
+		select {
+		case ch <- update:
+		default:
+			// replace the update the subscriber did not read yet
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- update
+		}
+	}
+}
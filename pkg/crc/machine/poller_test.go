@@ -0,0 +1,44 @@
+package machine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/machine/state"
+	"github.com/code-ready/crc/pkg/crc/machine/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type statusMachine struct {
+	Client
+	statuses chan state.State
+}
+
+func (m *statusMachine) Status() (*types.ClusterStatusResult, error) {
+	return &types.ClusterStatusResult{CrcStatus: <-m.statuses}, nil
+}
+
+func TestStatusPoller(t *testing.T) {
+	machine := &statusMachine{statuses: make(chan state.State)}
+	poller := NewStatusPoller(machine, time.Hour)
+
+	updates, unsubscribe := poller.Subscribe()
+	machine.statuses <- state.Running
+	assert.Equal(t, state.Running, (<-updates).Status.CrcStatus)
+
+	// unchanged status, no update
+	poller.Refresh()
+	machine.statuses <- state.Running
+	poller.Refresh()
+	machine.statuses <- state.Stopped
+	assert.Equal(t, state.Stopped, (<-updates).Status.CrcStatus)
+
+	// the last status is replayed to new subscribers
+	other, unsubscribeOther := poller.Subscribe()
+	assert.Equal(t, state.Stopped, (<-other).Status.CrcStatus)
+	unsubscribeOther()
+
+	unsubscribe()
+	_, ok := <-updates
+	assert.False(t, ok)
+}
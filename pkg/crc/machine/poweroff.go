@@ -3,6 +3,7 @@ package machine
 import "github.com/pkg/errors"
 
 func (client *client) PowerOff() error {
+	client.closeStatusRunner()
 	libMachineAPIClient, cleanup := createLibMachineClient()
 	defer cleanup()
 
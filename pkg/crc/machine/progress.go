@@ -0,0 +1,69 @@
+package machine
+
+import (
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/machine/types"
+)
+
+// startProgress keeps track of the current phase of a start and reports phase
+// transitions to the callback of the start configuration.
+type startProgress struct {
+	notify  func(types.StartEvent)
+	now     func() time.Time
+	current *types.StartEvent
+}
+
+func newStartProgress(notify func(types.StartEvent)) *startProgress {
+	return &startProgress{
+		notify: notify,
+		now:    time.Now,
+	}
+}
+
+// begin ends the current phase successfully and starts a new one
+func (p *startProgress) begin(phase types.StartPhase, description string) {
+	p.end(nil)
+	p.current = &types.StartEvent{
+		Phase:       phase,
+		Description: description,
+		StartTime:   p.now(),
+		Outcome:     types.PhaseInProgress,
+	}
+	p.emit(*p.current)
+}
+
+// end ends the current phase, if any, with an outcome depending on err
+func (p *startProgress) end(err error) {
+	if err != nil {
+		p.endWithOutcome(types.PhaseFailed, err)
+		return
+	}
+	p.endWithOutcome(types.PhaseSucceeded, nil)
+}
+
+// warn ends the current phase, if any, with err as a warning as the start
+// goes on
+func (p *startProgress) warn(err error) {
+	p.endWithOutcome(types.PhaseWarning, err)
+}
+
+func (p *startProgress) endWithOutcome(outcome types.PhaseOutcome, err error) {
+	if p.current == nil {
+		return
+	}
+	event := *p.current
+	p.current = nil
+	event.EndTime = p.now()
+	event.Outcome = outcome
+	if err != nil {
+		event.Error = err.Error()
+	}
+	p.emit(event)
+}
+
+func (p *startProgress) emit(event types.StartEvent) {
+	if p.notify != nil {
+		p.notify(event)
+	}
+}
@@ -0,0 +1,51 @@
+package machine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/code-ready/crc/pkg/crc/machine/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartProgress(t *testing.T) {
+	var events []types.StartEvent
+	progress := newStartProgress(func(event types.StartEvent) {
+		events = append(events, event)
+	})
+	clock := time.Date(2021, time.June, 1, 10, 0, 0, 0, time.UTC)
+	progress.now = func() time.Time {
+		clock = clock.Add(time.Second)
+		return clock
+	}
+
+	progress.begin(types.PhaseStartVM, "Starting the VM")
+	progress.begin(types.PhaseWaitForSSH, "Waiting for SSH")
+	progress.end(errors.New("ssh timeout"))
+	progress.end(nil)
+	progress.begin(types.PhaseWaitForCluster, "Waiting for the cluster")
+	progress.warn(errors.New("operators are degraded"))
+	progress.begin(types.PhaseKubeconfig, "Updating the kubeconfig")
+	progress.end(nil)
+
+	at := func(seconds int) time.Time {
+		return time.Date(2021, time.June, 1, 10, 0, seconds, 0, time.UTC)
+	}
+	assert.Equal(t, []types.StartEvent{
+		{Phase: types.PhaseStartVM, Description: "Starting the VM", StartTime: at(1), Outcome: types.PhaseInProgress},
+		{Phase: types.PhaseStartVM, Description: "Starting the VM", StartTime: at(1), EndTime: at(2), Outcome: types.PhaseSucceeded},
+		{Phase: types.PhaseWaitForSSH, Description: "Waiting for SSH", StartTime: at(3), Outcome: types.PhaseInProgress},
+		{Phase: types.PhaseWaitForSSH, Description: "Waiting for SSH", StartTime: at(3), EndTime: at(4), Outcome: types.PhaseFailed, Error: "ssh timeout"},
+		{Phase: types.PhaseWaitForCluster, Description: "Waiting for the cluster", StartTime: at(5), Outcome: types.PhaseInProgress},
+		{Phase: types.PhaseWaitForCluster, Description: "Waiting for the cluster", StartTime: at(5), EndTime: at(6), Outcome: types.PhaseWarning, Error: "operators are degraded"},
+		{Phase: types.PhaseKubeconfig, Description: "Updating the kubeconfig", StartTime: at(7), Outcome: types.PhaseInProgress},
+		{Phase: types.PhaseKubeconfig, Description: "Updating the kubeconfig", StartTime: at(7), EndTime: at(8), Outcome: types.PhaseSucceeded},
+	}, events)
+}
+
+func TestStartProgressWithoutCallback(t *testing.T) {
+	progress := newStartProgress(nil)
+	progress.begin(types.PhaseValidate, "Validating")
+	progress.end(nil)
+}
@@ -0,0 +1,43 @@
+package machine
+
+import (
+	"fmt"
+
+	"github.com/code-ready/crc/pkg/crc/logging"
+	libmachinestate "github.com/code-ready/machine/libmachine/state"
+	"github.com/pkg/errors"
+)
+
+// Resize changes the memory (in MiB) and the number of vCPUs of the running
+// VM, a zero value keeps the current one. The changes are not persisted, the
+// VM uses the configured values again on next start.
+func (client *client) Resize(memory, cpus int) error {
+	libMachineAPIClient, cleanup := createLibMachineClient()
+	defer cleanup()
+
+	host, err := libMachineAPIClient.Load(client.name)
+	if err != nil {
+		return errors.Wrap(err, "Cannot load machine")
+	}
+	vmState, err := host.Driver.GetState()
+	if err != nil {
+		return errors.Wrap(err, "Cannot get machine state")
+	}
+	if vmState != libmachinestate.Running {
+		return fmt.Errorf("Cannot resize the CodeReady Containers VM, it is %s", vmState)
+	}
+
+	if cpus > 0 {
+		logging.Infof("Setting the number of vCPUs to %d...", cpus)
+		if err := setLiveVcpus(client.name, cpus); err != nil {
+			return err
+		}
+	}
+	if memory > 0 {
+		logging.Infof("Setting the memory size to %d MiB...", memory)
+		if err := setLiveMemory(client.name, memory); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package machine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// setMaximumResources sets the maximum memory (in MiB) and number of vCPUs
+// of the stopped VM so that they can be increased later on the running VM.
+// When max-cpus or max-memory is unset or lower than cpus or memory, the
+// maximum is reset to the current value.
+func setMaximumResources(name string, memory, maxMemory, cpus, maxCPUs int) error {
+	if maxCPUs < cpus {
+		maxCPUs = cpus
+	}
+	currentMaxCPUs, err := getMaxVcpus(name, "--config")
+	if err != nil {
+		return err
+	}
+	if maxCPUs != currentMaxCPUs {
+		if _, err := virsh("setvcpus", name, strconv.Itoa(maxCPUs), "--maximum", "--config"); err != nil {
+			return fmt.Errorf("Failed to set the maximum number of vCPUs: %v", err)
+		}
+		if _, err := virsh("setvcpus", name, strconv.Itoa(cpus), "--config"); err != nil {
+			return fmt.Errorf("Failed to set the number of vCPUs: %v", err)
+		}
+	}
+
+	if maxMemory < memory {
+		maxMemory = memory
+	}
+	// the VM is stopped, dominfo shows its persistent definition
+	currentMaxMemory, err := getMaxMemory(name)
+	if err != nil {
+		return err
+	}
+	if maxMemory != currentMaxMemory {
+		if _, err := virsh("setmaxmem", name, strconv.Itoa(maxMemory*1024), "--config"); err != nil {
+			return fmt.Errorf("Failed to set the maximum memory size: %v", err)
+		}
+		if _, err := virsh("setmem", name, strconv.Itoa(memory*1024), "--config"); err != nil {
+			return fmt.Errorf("Failed to set the memory size: %v", err)
+		}
+	}
+	return nil
+}
+
+func setLiveVcpus(name string, cpus int) error {
+	maxCPUs, err := getMaxVcpus(name, "--live")
+	if err != nil {
+		return err
+	}
+	if cpus > maxCPUs {
+		return fmt.Errorf("The VM cannot use more than %d vCPUs, set 'max-cpus' and restart the cluster to raise this limit", maxCPUs)
+	}
+	if _, err := virsh("setvcpus", name, strconv.Itoa(cpus), "--live"); err != nil {
+		return fmt.Errorf("Failed to set the number of vCPUs: %v", err)
+	}
+	return nil
+}
+
+func setLiveMemory(name string, memory int) error {
+	maxMemory, err := getMaxMemory(name)
+	if err != nil {
+		return err
+	}
+	if memory > maxMemory {
+		return fmt.Errorf("The VM cannot use more than %d MiB of memory, set 'max-memory' and restart the cluster to raise this limit", maxMemory)
+	}
+	if _, err := virsh("setmem", name, strconv.Itoa(memory*1024), "--live"); err != nil {
+		return fmt.Errorf("Failed to set the memory size: %v", err)
+	}
+	return nil
+}
+
+// getMaxVcpus returns the maximum number of vCPUs of the running VM with
+// --live or of its persistent definition with --config
+func getMaxVcpus(name, scope string) (int, error) {
+	stdout, err := virsh("vcpucount", name, "--maximum", scope)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to get the maximum number of vCPUs: %v", err)
+	}
+	maxCPUs, err := strconv.Atoi(strings.TrimSpace(stdout))
+	if err != nil {
+		return 0, fmt.Errorf("Failed to parse the maximum number of vCPUs: %v", err)
+	}
+	return maxCPUs, nil
+}
+
+func getMaxMemory(name string) (int, error) {
+	stdout, err := virsh("dominfo", name)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to get the maximum memory size: %v", err)
+	}
+	return parseMaxMemory(stdout)
+}
+
+// parseMaxMemory returns the maximum memory in MiB from the output of
+// 'virsh dominfo', which contains a line such as 'Max memory:     9437184 KiB'
+func parseMaxMemory(dominfo string) (int, error) {
+	for _, line := range strings.Split(dominfo, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 4 && fields[0] == "Max" && fields[1] == "memory:" && fields[3] == "KiB" {
+			kib, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return 0, fmt.Errorf("Failed to parse the maximum memory size: %v", err)
+			}
+			return kib / 1024, nil
+		}
+	}
+	return 0, fmt.Errorf("Failed to find the maximum memory size of the VM")
+}
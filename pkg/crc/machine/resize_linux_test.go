@@ -0,0 +1,32 @@
+package machine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const dominfo = `Id:             1
+Name:           crc
+UUID:           7f3b1c2e-4d5a-4f6b-9c8d-0e1f2a3b4c5d
+OS Type:        hvm
+State:          running
+CPU(s):         4
+CPU time:       1204.5s
+Max memory:     16777216 KiB
+Used memory:    9437184 KiB
+Persistent:     yes
+Autostart:      disable
+Managed save:   no
+Security model: selinux
+Security DOI:   0
+`
+
+func TestParseMaxMemory(t *testing.T) {
+	maxMemory, err := parseMaxMemory(dominfo)
+	assert.NoError(t, err)
+	assert.Equal(t, 16384, maxMemory)
+
+	_, err = parseMaxMemory("Name: crc\n")
+	assert.Error(t, err)
+}
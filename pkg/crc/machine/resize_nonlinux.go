@@ -0,0 +1,23 @@
+// +build !linux
+
+package machine
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func setMaximumResources(name string, memory, maxMemory, cpus, maxCPUs int) error {
+	if maxMemory > memory || maxCPUs > cpus {
+		return fmt.Errorf("Memory and vCPUs hot-plug is not supported on %s", runtime.GOOS)
+	}
+	return nil
+}
+
+func setLiveVcpus(name string, cpus int) error {
+	return fmt.Errorf("Resizing the VM is not supported on %s", runtime.GOOS)
+}
+
+func setLiveMemory(name string, memory int) error {
+	return fmt.Errorf("Resizing the VM is not supported on %s", runtime.GOOS)
+}
@@ -0,0 +1,56 @@
+package machine
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	crcConfig "github.com/code-ready/crc/pkg/crc/config"
+	"github.com/code-ready/crc/pkg/crc/constants"
+	"github.com/code-ready/crc/pkg/crc/logging"
+	crcssh "github.com/code-ready/crc/pkg/crc/ssh"
+)
+
+// sharedDirTagPrefix starts the virtiofs tags of the directories shared by crc
+const sharedDirTagPrefix = "crc-share-"
+
+func sharedDirTag(index int) string {
+	return fmt.Sprintf("%s%d", sharedDirTagPrefix, index)
+}
+
+// applySharedDirs adds the shared directories to the definition of the stopped
+// VM, the definition is only read and modified when they changed since the
+// previous start
+func applySharedDirs(name string, dirs []crcConfig.SharedDir) error {
+	path := constants.GetSharedDirsPath(name)
+	formatted := crcConfig.FormatSharedDirs(dirs)
+	applied, err := ioutil.ReadFile(path)
+	if err != nil && len(dirs) == 0 {
+		return nil
+	}
+	if err == nil && string(applied) == formatted {
+		return nil
+	}
+	if err := configureSharedDirs(name, dirs); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(formatted), 0600)
+}
+
+// mountSharedDirs mounts the shared directories in the VM with the SELinux
+// context of container files so that pods can use them as hostPath volumes
+func mountSharedDirs(sshRunner *crcssh.Runner, dirs []crcConfig.SharedDir) error {
+	for i, dir := range dirs {
+		if _, _, err := sshRunner.Run("mountpoint", "-q", dir.Target); err == nil {
+			continue
+		}
+		logging.Infof("Mounting %s at %s in the VM", dir.Source, dir.Target)
+		if _, _, err := sshRunner.RunPrivileged("Creating the shared directory mount point", "mkdir", "-p", dir.Target); err != nil {
+			return err
+		}
+		if _, _, err := sshRunner.RunPrivileged(fmt.Sprintf("Mounting %s", dir.Target), "mount", "-t", "virtiofs",
+			"-o", "context=system_u:object_r:container_file_t:s0", sharedDirTag(i), dir.Target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package machine
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	crcConfig "github.com/code-ready/crc/pkg/crc/config"
+)
+
+// virtiofs needs the guest memory to be shared with the virtiofsd process
+const sharedMemoryBacking = `<memoryBacking>
+    <source type='memfd'/>
+    <access mode='shared'/>
+  </memoryBacking>
+  `
+
+var sharedDirRegexp = regexp.MustCompile(`<source dir='([^']*)'/>\s*<target dir='(` + sharedDirTagPrefix + `\d+)'/>`)
+
+// configureSharedDirs adds the shared directories as virtiofs filesystems to
+// the persistent definition of the stopped VM
+func configureSharedDirs(name string, dirs []crcConfig.SharedDir) error {
+	domainXML, err := virsh("dumpxml", "--inactive", name)
+	if err != nil {
+		return err
+	}
+	updated, changed, err := updateSharedDirs(domainXML, dirs)
+	if err != nil || !changed {
+		return err
+	}
+	return defineDomain(updated)
+}
+
+// updateSharedDirs replaces the virtiofs filesystems added by crc to a domain
+// XML definition. It reports whether the shared directories changed.
+func updateSharedDirs(domainXML string, dirs []crcConfig.SharedDir) (string, bool, error) {
+	updated, current := removeSharedDirs(domainXML)
+
+	var filesystems strings.Builder
+	var desired []string
+	for i, dir := range dirs {
+		var source bytes.Buffer
+		if err := xml.EscapeText(&source, []byte(dir.Source)); err != nil {
+			return "", false, err
+		}
+		filesystem := fmt.Sprintf("<source dir='%s'/>\n      <target dir='%s'/>", source.String(), sharedDirTag(i))
+		desired = append(desired, sharedDirRegexp.FindString(filesystem))
+		fmt.Fprintf(&filesystems, "<filesystem type='mount' accessmode='passthrough'>\n      <driver type='virtiofs'/>\n      %s\n    </filesystem>\n    ", filesystem)
+	}
+	if equalSharedDirs(current, desired) {
+		return domainXML, false, nil
+	}
+	if len(dirs) == 0 {
+		return updated, true, nil
+	}
+
+	if !strings.Contains(updated, "<memoryBacking>") {
+		index := strings.Index(updated, "<vcpu")
+		if index == -1 {
+			return "", false, errors.New("Cannot find the vcpu element in the domain definition")
+		}
+		updated = updated[:index] + sharedMemoryBacking + updated[index:]
+	} else if !strings.Contains(updated, "<access mode='shared'/>") {
+		return "", false, errors.New("The memory of the VM is not shared, virtiofs cannot be used")
+	}
+
+	index := strings.LastIndex(updated, "</devices>")
+	if index == -1 {
+		return "", false, errors.New("Cannot find the devices element in the domain definition")
+	}
+	return updated[:index] + filesystems.String() + updated[index:], true, nil
+}
+
+// removeSharedDirs removes the filesystem elements added by crc from a domain
+// XML definition, it also returns their source and target
+func removeSharedDirs(domainXML string) (string, []string) {
+	var removed []string
+	var kept strings.Builder
+	for {
+		start := strings.Index(domainXML, "<filesystem ")
+		if start == -1 {
+			break
+		}
+		length := strings.Index(domainXML[start:], "</filesystem>")
+		if length == -1 {
+			break
+		}
+		end := start + length + len("</filesystem>")
+		filesystem := domainXML[start:end]
+		if sharedDir := sharedDirRegexp.FindString(filesystem); sharedDir != "" {
+			removed = append(removed, sharedDir)
+			kept.WriteString(strings.TrimRight(domainXML[:start], " "))
+			domainXML = strings.TrimPrefix(domainXML[end:], "\n")
+		} else {
+			kept.WriteString(domainXML[:end])
+			domainXML = domainXML[end:]
+		}
+	}
+	kept.WriteString(domainXML)
+	return kept.String(), removed
+}
+
+func equalSharedDirs(current, desired []string) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+	for i := range current {
+		// ignore the indentation of the dumped XML
+		if strings.Join(strings.Fields(current[i]), " ") != strings.Join(strings.Fields(desired[i]), " ") {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package machine
+
+import (
+	"testing"
+
+	crcConfig "github.com/code-ready/crc/pkg/crc/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const domainXML = `<domain type='kvm'>
+  <name>crc</name>
+  <memory unit='KiB'>9437184</memory>
+  <currentMemory unit='KiB'>9437184</currentMemory>
+  <vcpu placement='static'>4</vcpu>
+  <devices>
+    <disk type='file' device='disk'>
+      <driver name='qemu' type='qcow2'/>
+      <source file='/home/user/.crc/machines/crc/crc.qcow2'/>
+      <target dev='vda' bus='virtio'/>
+    </disk>
+  </devices>
+</domain>`
+
+const sharedDomainXML = `<domain type='kvm'>
+  <name>crc</name>
+  <memory unit='KiB'>9437184</memory>
+  <currentMemory unit='KiB'>9437184</currentMemory>
+  <memoryBacking>
+    <source type='memfd'/>
+    <access mode='shared'/>
+  </memoryBacking>
+  <vcpu placement='static'>4</vcpu>
+  <devices>
+    <disk type='file' device='disk'>
+      <driver name='qemu' type='qcow2'/>
+      <source file='/home/user/.crc/machines/crc/crc.qcow2'/>
+      <target dev='vda' bus='virtio'/>
+    </disk>
+    <filesystem type='mount' accessmode='passthrough'>
+      <driver type='virtiofs'/>
+      <source dir='/home/user/src'/>
+      <target dir='crc-share-0'/>
+      <address type='pci' domain='0x0000' bus='0x07' slot='0x00' function='0x0'/>
+    </filesystem>
+  </devices>
+</domain>`
+
+func TestUpdateSharedDirs(t *testing.T) {
+	dirs := []crcConfig.SharedDir{{Source: "/home/user/src", Target: "/mnt/src"}}
+
+	updated, changed, err := updateSharedDirs(domainXML, dirs)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Contains(t, updated, "<access mode='shared'/>")
+	assert.Contains(t, updated, "<source dir='/home/user/src'/>\n      <target dir='crc-share-0'/>")
+
+	_, changed, err = updateSharedDirs(sharedDomainXML, dirs)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	updated, changed, err = updateSharedDirs(sharedDomainXML, []crcConfig.SharedDir{{Source: "/tmp/fixtures", Target: "/mnt/fixtures"}})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotContains(t, updated, "/home/user/src")
+	assert.Contains(t, updated, "<source dir='/tmp/fixtures'/>")
+
+	updated, changed, err = updateSharedDirs(sharedDomainXML, nil)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotContains(t, updated, "<filesystem")
+	assert.Contains(t, updated, "    </disk>\n  </devices>")
+}
@@ -0,0 +1,17 @@
+// +build !linux
+
+package machine
+
+import (
+	"fmt"
+	"runtime"
+
+	crcConfig "github.com/code-ready/crc/pkg/crc/config"
+)
+
+func configureSharedDirs(name string, dirs []crcConfig.SharedDir) error {
+	if len(dirs) > 0 {
+		return fmt.Errorf("Sharing host directories is not supported on %s", runtime.GOOS)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package machine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/code-ready/crc/pkg/crc/constants"
+	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/machine/types"
+	"github.com/code-ready/crc/pkg/libmachine/host"
+	crcos "github.com/code-ready/crc/pkg/os"
+	libmachinestate "github.com/code-ready/machine/libmachine/state"
+	"github.com/pkg/errors"
+)
+
+var validSnapshotName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+// snapshotDir is where the instance files which must match the disk state,
+// such as the kubeconfig and the kubeadmin password, are saved along with a snapshot
+func snapshotDir(name, snapshot string) string {
+	return filepath.Join(constants.GetInstanceDir(name), "snapshots", snapshot)
+}
+
+func snapshotFiles(name string) []string {
+	return []string{
+		constants.GetKubeconfigFilePath(name),
+		constants.GetKubeAdminPasswordPath(name),
+	}
+}
+
+func validateSnapshotName(snapshot string) error {
+	if !validSnapshotName.MatchString(snapshot) {
+		return fmt.Errorf("Invalid snapshot name '%s'", snapshot)
+	}
+	return nil
+}
+
+func (client *client) SaveSnapshot(snapshot string) error {
+	if err := validateSnapshotName(snapshot); err != nil {
+		return err
+	}
+	if err := client.ensureStopped(); err != nil {
+		return err
+	}
+	dir := snapshotDir(client.name, snapshot)
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("Snapshot '%s' already exists", snapshot)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	for _, file := range snapshotFiles(client.name) {
+		if !crcos.FileExists(file) {
+			continue
+		}
+		if err := crcos.CopyFileContents(file, filepath.Join(dir, filepath.Base(file)), 0600); err != nil {
+			_ = os.RemoveAll(dir)
+			return errors.Wrapf(err, "Cannot save %s", file)
+		}
+	}
+	logging.Infof("Saving snapshot %s...", snapshot)
+	if err := createSnapshot(client.name, snapshot); err != nil {
+		_ = os.RemoveAll(dir)
+		return err
+	}
+	return nil
+}
+
+func (client *client) ListSnapshots() ([]types.SnapshotDetails, error) {
+	if err := client.ensureExists(); err != nil {
+		return nil, err
+	}
+	names, err := listSnapshots(client.name)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := []types.SnapshotDetails{}
+	for _, name := range names {
+		details := types.SnapshotDetails{
+			Name: name,
+		}
+		if info, err := os.Stat(snapshotDir(client.name, name)); err == nil {
+			details.CreationTime = info.ModTime()
+		}
+		snapshots = append(snapshots, details)
+	}
+	return snapshots, nil
+}
+
+func (client *client) RestoreSnapshot(snapshot string) error {
+	if err := validateSnapshotName(snapshot); err != nil {
+		return err
+	}
+	if err := client.ensureStopped(); err != nil {
+		return err
+	}
+	logging.Infof("Restoring snapshot %s...", snapshot)
+	if err := revertSnapshot(client.name, snapshot); err != nil {
+		return err
+	}
+	dir := snapshotDir(client.name, snapshot)
+	for _, file := range snapshotFiles(client.name) {
+		saved := filepath.Join(dir, filepath.Base(file))
+		if !crcos.FileExists(saved) {
+			continue
+		}
+		if err := crcos.CopyFileContents(saved, file, 0600); err != nil {
+			return errors.Wrapf(err, "Cannot restore %s", file)
+		}
+	}
+	client.vmDetails.Storage.Flush()
+	return nil
+}
+
+func (client *client) DeleteSnapshot(snapshot string) error {
+	if err := validateSnapshotName(snapshot); err != nil {
+		return err
+	}
+	if err := client.ensureExists(); err != nil {
+		return err
+	}
+	if err := deleteSnapshot(client.name, snapshot); err != nil {
+		return err
+	}
+	return os.RemoveAll(snapshotDir(client.name, snapshot))
+}
+
+func (client *client) ensureExists() error {
+	exists, err := client.Exists()
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.New("Machine doesn't exist")
+	}
+	return nil
+}
+
+// ensureStopped checks the instance is stopped, snapshots are only taken and
+// restored offline so that the disk is consistent
+func (client *client) ensureStopped() error {
+	libMachineAPIClient, cleanup := createLibMachineClient()
+	defer cleanup()
+
+	host, err := libMachineAPIClient.Load(client.name)
+	if err != nil {
+		return errors.Wrap(err, "Cannot load machine")
+	}
+	return ensureHostStopped(host)
+}
+
+func ensureHostStopped(host *host.Host) error {
+	vmState, err := host.Driver.GetState()
+	if err != nil {
+		return errors.Wrap(err, "Cannot get machine state")
+	}
+	if vmState != libmachinestate.Stopped {
+		return fmt.Errorf("The instance must be stopped, run 'crc stop --instance %s' first", host.Name)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package machine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/code-ready/crc/pkg/crc/logging"
+	crcos "github.com/code-ready/crc/pkg/os"
+)
+
+func virsh(args ...string) (string, error) {
+	stdout, stderr, err := crcos.RunWithDefaultLocale("virsh", append([]string{"--connect", "qemu:///system"}, args...)...)
+	if err != nil {
+		return "", fmt.Errorf("%v: %s", err, strings.TrimSpace(stderr))
+	}
+	return stdout, nil
+}
+
+// createSnapshot uses an internal qcow2 snapshot of the instance disk
+func createSnapshot(name, snapshot string) error {
+	if _, err := virsh("snapshot-create-as", name, snapshot); err != nil {
+		return fmt.Errorf("Failed to create snapshot '%s': %v", snapshot, err)
+	}
+	return nil
+}
+
+func listSnapshots(name string) ([]string, error) {
+	stdout, err := virsh("snapshot-list", name, "--name")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list snapshots: %v", err)
+	}
+	var snapshots []string
+	for _, line := range strings.Split(stdout, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			snapshots = append(snapshots, line)
+		}
+	}
+	return snapshots, nil
+}
+
+func revertSnapshot(name, snapshot string) error {
+	if _, err := virsh("snapshot-revert", name, snapshot); err != nil {
+		return fmt.Errorf("Failed to restore snapshot '%s': %v", snapshot, err)
+	}
+	return nil
+}
+
+func deleteSnapshot(name, snapshot string) error {
+	if _, err := virsh("snapshot-delete", name, snapshot); err != nil {
+		return fmt.Errorf("Failed to delete snapshot '%s': %v", snapshot, err)
+	}
+	return nil
+}
+
+// deleteAllSnapshots must be called before removing the domain, libvirt
+// refuses to undefine a domain which has snapshots
+func deleteAllSnapshots(name string) {
+	snapshots, err := listSnapshots(name)
+	if err != nil {
+		logging.Debugf("Cannot list snapshots: %v", err)
+		return
+	}
+	for _, snapshot := range snapshots {
+		if err := deleteSnapshot(name, snapshot); err != nil {
+			logging.Warn(err)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+// +build !linux
+
+package machine
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func createSnapshot(name, snapshot string) error {
+	return fmt.Errorf("Snapshots are not supported on %s", runtime.GOOS)
+}
+
+func listSnapshots(name string) ([]string, error) {
+	return nil, fmt.Errorf("Snapshots are not supported on %s", runtime.GOOS)
+}
+
+func revertSnapshot(name, snapshot string) error {
+	return fmt.Errorf("Snapshots are not supported on %s", runtime.GOOS)
+}
+
+func deleteSnapshot(name, snapshot string) error {
+	return fmt.Errorf("Snapshots are not supported on %s", runtime.GOOS)
+}
+
+func deleteAllSnapshots(name string) {
+}
@@ -0,0 +1,16 @@
+package machine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidSnapshotName(t *testing.T) {
+	client := &client{name: "crc"}
+	for _, snapshot := range []string{"", "..", "../crc", "a/b", "-test"} {
+		assert.EqualErrorf(t, client.SaveSnapshot(snapshot), "Invalid snapshot name '"+snapshot+"'", "save %s", snapshot)
+		assert.EqualErrorf(t, client.RestoreSnapshot(snapshot), "Invalid snapshot name '"+snapshot+"'", "restore %s", snapshot)
+		assert.EqualErrorf(t, client.DeleteSnapshot(snapshot), "Invalid snapshot name '"+snapshot+"'", "delete %s", snapshot)
+	}
+}
@@ -79,6 +79,19 @@ func (client *client) updateVMConfig(startConfig types.StartConfig, api libmachi
 	if err := api.Save(host); err != nil {
 		return err
 	}
+	if err := setMaximumResources(client.name, startConfig.Memory, startConfig.MaxMemory, startConfig.CPUs, startConfig.MaxCPUs); err != nil {
+		logging.Warnf("Memory and vCPUs cannot be increased while the VM is running: %v", err)
+	}
+	if startConfig.DataDiskSize > 0 {
+		if err := attachDataDisk(client.name, startConfig.DataDiskSize); err != nil {
+			return errors.Wrap(err, "Failed to attach the data disk")
+		}
+	} else if err := detachDataDisk(client.name); err != nil {
+		return errors.Wrap(err, "Failed to detach the data disk")
+	}
+	if err := applySharedDirs(client.name, startConfig.SharedDirs); err != nil {
+		return errors.Wrap(err, "Failed to share the host directories")
+	}
 
 	/* Disk size */
 	if startConfig.DiskSize != constants.DefaultDiskSize {
@@ -134,6 +147,14 @@ func growRootFileSystem(sshRunner *crcssh.Runner) error {
 	return nil
 }
 func (client *client) Start(ctx context.Context, startConfig types.StartConfig) (*types.StartResult, error) {
+	progress := newStartProgress(startConfig.Progress)
+	result, err := client.start(ctx, startConfig, progress)
+	progress.end(err)
+	return result, err
+}
+
+func (client *client) start(ctx context.Context, startConfig types.StartConfig, progress *startProgress) (*types.StartResult, error) {
+	progress.begin(types.PhaseValidate, "Validating the start configuration")
 	telemetry.SetCPUs(ctx, startConfig.CPUs)
 	telemetry.SetMemory(ctx, uint64(startConfig.Memory)*1024*1024)
 	telemetry.SetDiskSize(ctx, uint64(startConfig.DiskSize)*1024*1024*1024)
@@ -145,6 +166,10 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 	libMachineAPIClient, cleanup := createLibMachineClient()
 	defer cleanup()
 
+	if err := ensureNoOtherInstanceRunning(libMachineAPIClient, client.name); err != nil {
+		return nil, err
+	}
+
 	// Pre-VM start
 	exists, err := client.Exists()
 	if err != nil {
@@ -154,6 +179,7 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 	bundleName := bundle.GetBundleNameWithoutExtension(filepath.Base(startConfig.BundlePath))
 
 	if !exists {
+		progress.begin(types.PhaseCreateVM, "Creating the CodeReady Containers VM")
 		telemetry.SetStartType(ctx, telemetry.CreationStartType)
 
 		// Ask early for pull secret if it hasn't been requested yet
@@ -200,6 +226,8 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "Error loading bundle metadata")
 	}
+	domains := client.domains()
+	crcBundleMetadata.UseDomains(domains.Cluster, domains.Apps)
 	currentBundleName := crcBundleMetadata.GetBundleName()
 	if currentBundleName != bundleName {
 		logging.Debugf("Bundle '%s' was requested, but the existing VM is using '%s'",
@@ -212,9 +240,12 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "Error getting the machine state")
 	}
+	if vmState == libmachinestate.Paused {
+		return nil, errors.New("The CodeReady Containers VM is paused, use 'crc resume' to resume it")
+	}
 	if vmState == libmachinestate.Running {
 		logging.Infof("A CodeReady Containers VM for OpenShift %s is already running", crcBundleMetadata.GetOpenshiftVersion())
-		clusterConfig, err := getClusterConfig(crcBundleMetadata)
+		clusterConfig, err := getClusterConfig(client.name, crcBundleMetadata)
 		if err != nil {
 			return nil, errors.Wrap(err, "Cannot create cluster configuration")
 		}
@@ -231,10 +262,17 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 		return nil, err
 	}
 
+	progress.begin(types.PhaseStartVM, "Starting the CodeReady Containers VM")
 	logging.Infof("Starting CodeReady Containers VM for OpenShift %s...", crcBundleMetadata.GetOpenshiftVersion())
 
+	// the instance boots with the user mode network it was configured for,
+	// vsock-subnet is written in the instance once it is running
+	vsockNetwork, err := InstanceVSockNetwork(client.name)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot read the user mode network configuration of the VM")
+	}
 	if client.useVSock() {
-		if err := exposePorts(); err != nil {
+		if err := exposePorts(vsockNetwork); err != nil {
 			return nil, err
 		}
 	}
@@ -267,46 +305,60 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 		return nil, errors.Wrap(err, "Error getting the IP")
 	}
 	logging.Infof("CodeReady Containers instance is running with IP %s", instanceIP)
-	sshRunner, err := crcssh.CreateRunner(instanceIP, getSSHPort(client.useVSock()), crcBundleMetadata.GetSSHKeyPath(), constants.GetPrivateKeyPath(), constants.GetRsaPrivateKeyPath())
+	sshRunner, err := crcssh.CreateRunner(instanceIP, getSSHPort(client.useVSock()), crcBundleMetadata.GetSSHKeyPath(), constants.GetPrivateKeyPath(client.name), constants.GetRsaPrivateKeyPath(client.name))
 	if err != nil {
 		return nil, errors.Wrap(err, "Error creating the ssh client")
 	}
 	defer sshRunner.Close()
 
+	progress.begin(types.PhaseWaitForSSH, "Waiting for SSH access to the VM")
 	logging.Debug("Waiting until ssh is available")
 	if err := sshRunner.WaitForConnectivity(ctx, 300*time.Second); err != nil {
 		return nil, errors.Wrap(err, "Failed to connect to the CRC VM with SSH -- host might be unreachable")
 	}
 	logging.Info("CodeReady Containers VM is running")
 
+	progress.begin(types.PhaseConfigureVM, "Configuring the CodeReady Containers VM")
+
 	// Post VM start immediately update SSH key and copy kubeconfig to instance
 	// dir and VM
-	if err := updateSSHKeyPair(sshRunner); err != nil {
+	if err := updateSSHKeyPair(sshRunner, constants.GetPublicKeyPath(client.name)); err != nil {
 		return nil, errors.Wrap(err, "Error updating public key")
 	}
 
+	if client.useVSock() {
+		if err := configureVSockNetwork(sshRunner, client.name, client.vsockNetwork()); err != nil {
+			return nil, errors.Wrap(err, "Error configuring the user mode network")
+		}
+		if vsockNetwork.String() != client.vsockNetwork().String() {
+			logging.Warnf("The VM is reconfigured for the %s subnet, it keeps using %s until it is stopped and the daemon is restarted",
+				client.vsockNetwork(), vsockNetwork)
+		}
+	}
+
 	// Trigger disk resize, this will be a no-op if no disk size change is needed
 	if err := growRootFileSystem(sshRunner); err != nil {
 		return nil, errors.Wrap(err, "Error updating filesystem size")
 	}
 
+	if startConfig.DataDiskSize > 0 {
+		if err := mountDataDisk(sshRunner); err != nil {
+			return nil, errors.Wrap(err, "Error mounting the data disk")
+		}
+	}
+
+	if err := mountSharedDirs(sshRunner, startConfig.SharedDirs); err != nil {
+		return nil, errors.Wrap(err, "Error mounting the shared directories")
+	}
+
 	// Start network time synchronization if `CRC_DEBUG_ENABLE_STOP_NTP` is not set
 	if stopNtp, _ := strconv.ParseBool(os.Getenv("CRC_DEBUG_ENABLE_STOP_NTP")); stopNtp {
 		logging.Info("Stopping network time synchronization in CodeReady Containers VM")
 		if _, _, err := sshRunner.RunPrivileged("Turning off the ntp server", "timedatectl set-ntp off"); err != nil {
 			return nil, errors.Wrap(err, "Failed to stop network time synchronization")
 		}
-		logging.Info("Setting clock to host clock (UTC timezone)")
-		dateCmd := fmt.Sprintf("date -s '%s'", time.Now().Format(time.UnixDate))
-		if _, _, err := sshRunner.RunPrivileged("Setting clock same as host", dateCmd); err != nil {
-			return nil, errors.Wrap(err, "Failed to set clock to same as host")
-		}
-	}
-
-	// Add nameserver to VM if provided by User
-	if startConfig.NameServer != "" {
-		if err = addNameServerToInstance(sshRunner, startConfig.NameServer); err != nil {
-			return nil, errors.Wrap(err, "Failed to add nameserver to the VM")
+		if err := syncClockWithHost(sshRunner); err != nil {
+			return nil, err
 		}
 	}
 
@@ -314,7 +366,7 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 		return nil, errors.Wrap(err, "Failed to change permissions to root podman socket")
 	}
 
-	proxyConfig, err := getProxyConfig(crcBundleMetadata.ClusterInfo.BaseDomain)
+	proxyConfig, err := getProxyConfig(crcBundleMetadata)
 	if err != nil {
 		return nil, errors.Wrap(err, "Error getting proxy configuration")
 	}
@@ -330,8 +382,14 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 		// TODO: should be more finegrained
 		BundleMetadata: *crcBundleMetadata,
 		NetworkMode:    client.networkMode(),
+		VSockNetwork:   vsockNetwork,
+		DNSRecords:     client.dnsRecords(),
+		UpstreamDNS:    client.upstreamDNS(),
+		NameServers:    startConfig.NameServers,
+		SearchDomains:  startConfig.SearchDomains,
 	}
 
+	progress.begin(types.PhaseDNS, "Starting the DNS server and checking DNS queries")
 	// Run the DNS server inside the VM
 	if err := dns.RunPostStart(servicePostStartConfig); err != nil {
 		return nil, errors.Wrap(err, "Error running post start")
@@ -358,6 +416,7 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 		logging.Warn(fmt.Sprintf("Failed to query DNS from host: %v", err))
 	}
 
+	progress.begin(types.PhaseStartKubelet, "Starting the OpenShift kubelet service")
 	if err := cluster.EnsurePullSecretPresentOnInstanceDisk(sshRunner, startConfig.PullSecret); err != nil {
 		return nil, errors.Wrap(err, "Failed to update VM pull secret")
 	}
@@ -381,6 +440,7 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 
 	ocConfig := oc.UseOCWithSSH(sshRunner)
 
+	progress.begin(types.PhaseWaitForAPIServer, "Waiting for the OpenShift API server")
 	if err := cluster.ApproveCSRAndWaitForCertsRenewal(sshRunner, ocConfig, certsExpired[cluster.KubeletClientCert], certsExpired[cluster.KubeletServerCert]); err != nil {
 		logBundleDate(crcBundleMetadata)
 		return nil, errors.Wrap(err, "Failed to renew TLS certificates: please check if a newer CodeReady Containers release is available")
@@ -390,9 +450,10 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 		return nil, errors.Wrap(err, "Error waiting for apiserver")
 	}
 
+	progress.begin(types.PhaseConfigureCluster, "Configuring the OpenShift cluster")
 	// Remove this check when we have official 4.8 bundle
 	if strings.HasPrefix(crcBundleMetadata.GetOpenshiftVersion(), "4.8.") {
-		if err := cluster.EnsureSSHKeyPresentInTheCluster(ocConfig, constants.GetPublicKeyPath()); err != nil {
+		if err := cluster.EnsureSSHKeyPresentInTheCluster(ocConfig, constants.GetPublicKeyPath(client.name)); err != nil {
 			return nil, errors.Wrap(err, "Failed to update ssh public key to machine config")
 		}
 	}
@@ -405,7 +466,7 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 		return nil, errors.Wrap(err, "Failed to update cluster pull secret")
 	}
 
-	if err := cluster.UpdateKubeAdminUserPassword(ocConfig, startConfig.KubeAdminPassword); err != nil {
+	if err := cluster.UpdateKubeAdminUserPassword(client.name, ocConfig, startConfig.KubeAdminPassword); err != nil {
 		return nil, errors.Wrap(err, "Failed to update kubeadmin user password")
 	}
 
@@ -413,6 +474,10 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 		return nil, errors.Wrap(err, "Failed to update cluster ID")
 	}
 
+	if err := ensureAppsDomain(client.name, ocConfig, sshRunner, crcBundleMetadata); err != nil {
+		return nil, errors.Wrap(err, "Failed to configure the apps domain")
+	}
+
 	if client.useVSock() {
 		if err := ensureRoutesControllerIsRunning(sshRunner, ocConfig); err != nil {
 			return nil, err
@@ -449,25 +514,29 @@ func (client *client) Start(ctx context.Context, startConfig types.StartConfig)
 		}
 	}
 
-	if err := updateKubeconfig(ocConfig, sshRunner, crcBundleMetadata.GetKubeConfigPath()); err != nil {
+	if err := updateKubeconfig(ocConfig, sshRunner, crcBundleMetadata.GetKubeConfigPath(), constants.GetKubeconfigFilePath(client.name)); err != nil {
 		return nil, errors.Wrap(err, "Failed to update kubeconfig file")
 	}
 
+	progress.begin(types.PhaseWaitForCluster, "Waiting for the OpenShift cluster to stabilize")
 	logging.Info("Starting OpenShift cluster... [waiting for the cluster to stabilize]")
-	if err := cluster.WaitForClusterStable(ctx, instanceIP, constants.KubeconfigFilePath); err != nil {
+	if err := cluster.WaitForClusterStable(ctx, instanceIP, constants.GetKubeconfigFilePath(client.name)); err != nil {
 		logging.Errorf("Cluster is not ready: %v", err)
+		progress.warn(err)
 	}
 
 	waitForProxyPropagation(ctx, ocConfig, proxyConfig)
 
-	clusterConfig, err := getClusterConfig(crcBundleMetadata)
+	clusterConfig, err := getClusterConfig(client.name, crcBundleMetadata)
 	if err != nil {
 		return nil, errors.Wrap(err, "Cannot get cluster configuration")
 	}
 
-	logging.Info("Adding crc-admin and crc-developer contexts to kubeconfig...")
-	if err := writeKubeconfig(instanceIP, clusterConfig); err != nil {
+	progress.begin(types.PhaseKubeconfig, "Adding the cluster contexts to the kubeconfig file")
+	logging.Infof("Adding %s and %s contexts to kubeconfig...", adminContext(client.name), developerContext(client.name))
+	if err := writeKubeconfig(client.name, instanceIP, clusterConfig, crcBundleMetadata.GetBuiltinAPIHostname()); err != nil {
 		logging.Errorf("Cannot update kubeconfig: %v", err)
+		progress.warn(err)
 	}
 
 	return &types.StartResult{
@@ -512,7 +581,7 @@ func (client *client) validateStartConfig(startConfig types.StartConfig) error {
 // special name. The name is the hex representation of the cid and the vsock port.
 // This function adds the unix socket in the hyperkit directory.
 func makeDaemonVisibleToHyperkit(name string) error {
-	dst := filepath.Join(constants.MachineInstanceDir, name, "00000002.00000400")
+	dst := filepath.Join(constants.GetInstanceDir(name), "00000002.00000400")
 	if _, err := os.Stat(dst); err != nil {
 		if !os.IsNotExist(err) {
 			return errors.Wrap(err, "VSock listener error")
@@ -547,10 +616,10 @@ func createHost(api libmachine.API, machineConfig config.MachineConfig) error {
 	}
 
 	logging.Info("Generating new SSH Key pair...")
-	if err := crcssh.GenerateSSHKey(constants.GetPrivateKeyPath()); err != nil {
+	if err := crcssh.GenerateSSHKey(constants.GetPrivateKeyPath(machineConfig.Name)); err != nil {
 		return fmt.Errorf("Error generating ssh key pair: %v", err)
 	}
-	if err := cluster.GenerateKubeAdminUserPassword(); err != nil {
+	if err := cluster.GenerateKubeAdminUserPassword(machineConfig.Name); err != nil {
 		return errors.Wrap(err, "Error generating new kubeadmin password")
 	}
 	if err := api.SetExists(vm.Name); err != nil {
@@ -580,23 +649,18 @@ func startHost(ctx context.Context, api libmachine.API, vm *host.Host) error {
 	return nil
 }
 
-func addNameServerToInstance(sshRunner *crcssh.Runner, ns string) error {
-	nameserver := network.NameServer{IPAddress: ns}
-	nameservers := []network.NameServer{nameserver}
-	exist, err := network.HasGivenNameserversConfigured(sshRunner, nameserver)
-	if err != nil {
-		return err
-	}
-	if !exist {
-		logging.Infof("Adding %s as nameserver to the instance...", nameserver.IPAddress)
-		return network.AddNameserversToInstance(sshRunner, nameservers)
+func syncClockWithHost(sshRunner *crcssh.Runner) error {
+	logging.Info("Setting clock to host clock (UTC timezone)")
+	dateCmd := fmt.Sprintf("date -s '%s'", time.Now().Format(time.UnixDate))
+	if _, _, err := sshRunner.RunPrivileged("Setting clock same as host", dateCmd); err != nil {
+		return errors.Wrap(err, "Failed to set clock to same as host")
 	}
 	return nil
 }
 
-func updateSSHKeyPair(sshRunner *crcssh.Runner) error {
+func updateSSHKeyPair(sshRunner *crcssh.Runner, publicKeyPath string) error {
 	// Read generated public key
-	publicKey, err := ioutil.ReadFile(constants.GetPublicKeyPath())
+	publicKey, err := ioutil.ReadFile(publicKeyPath)
 	if err != nil {
 		return err
 	}
@@ -617,7 +681,7 @@ func updateSSHKeyPair(sshRunner *crcssh.Runner) error {
 }
 
 func copyKubeconfigFileWithUpdatedUserClientCertAndKey(selfSignedCAKey *rsa.PrivateKey, selfSignedCACert *x509.Certificate, srcKubeConfigPath, dstKubeConfigPath string) error {
-	if _, err := os.Stat(constants.KubeconfigFilePath); err == nil {
+	if _, err := os.Stat(dstKubeConfigPath); err == nil {
 		return nil
 	}
 	clientKey, clientCert, err := crctls.GenerateClientCertificate(selfSignedCAKey, selfSignedCACert)
@@ -710,19 +774,36 @@ func ensureRoutesControllerIsRunning(sshRunner *crcssh.Runner, ocConfig oc.Confi
 	return nil
 }
 
-func updateKubeconfig(ocConfig oc.Config, sshRunner *crcssh.Runner, kubeconfigFilePath string) error {
+// ensureAppsDomain moves the routes to the apps-domain of the configuration, or
+// back to the domain of the bundle when the default apps-domain is used
+func ensureAppsDomain(name string, ocConfig oc.Config, sshRunner *crcssh.Runner, crcBundleMetadata *bundle.CrcBundleInfo) error {
+	caFile := constants.GetIngressCAPath(name)
+	if crcBundleMetadata.GetAppsDomain() != crcBundleMetadata.ClusterInfo.AppsDomain {
+		logging.Infof("Configuring the cluster to use the %s apps domain...", crcBundleMetadata.GetAppsDomain())
+		return cluster.EnsureAppsDomain(ocConfig, sshRunner, crcBundleMetadata.GetAppsDomain(), caFile)
+	}
+	if err := cluster.ResetAppsDomain(ocConfig); err != nil {
+		return err
+	}
+	if err := os.Remove(caFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func updateKubeconfig(ocConfig oc.Config, sshRunner *crcssh.Runner, kubeconfigFilePath, instanceKubeconfigFilePath string) error {
 	selfSignedCAKey, selfSignedCACert, err := crctls.GetSelfSignedCA()
 	if err != nil {
 		return errors.Wrap(err, "Not able to generate root CA key and Cert")
 	}
-	if err := copyKubeconfigFileWithUpdatedUserClientCertAndKey(selfSignedCAKey, selfSignedCACert, kubeconfigFilePath, constants.KubeconfigFilePath); err != nil {
-		return errors.Wrapf(err, "Failed to copy kubeconfig file: %s", constants.KubeconfigFilePath)
+	if err := copyKubeconfigFileWithUpdatedUserClientCertAndKey(selfSignedCAKey, selfSignedCACert, kubeconfigFilePath, instanceKubeconfigFilePath); err != nil {
+		return errors.Wrapf(err, "Failed to copy kubeconfig file: %s", instanceKubeconfigFilePath)
 	}
-	adminClientCA, err := adminClientCertificate(constants.KubeconfigFilePath)
+	adminClientCA, err := adminClientCertificate(instanceKubeconfigFilePath)
 	if err != nil {
 		return errors.Wrap(err, "Not able to get user CA")
 	}
-	if err := cluster.EnsureGeneratedClientCAPresentInTheCluster(ocConfig, sshRunner, selfSignedCACert, adminClientCA); err != nil {
+	if err := cluster.EnsureGeneratedClientCAPresentInTheCluster(ocConfig, sshRunner, selfSignedCACert, adminClientCA, instanceKubeconfigFilePath); err != nil {
 		return errors.Wrap(err, "Failed to update user CA to cluster")
 	}
 	return nil
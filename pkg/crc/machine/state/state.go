@@ -10,6 +10,7 @@ const (
 	Stopped  State = "Stopped"
 	Stopping State = "Stopping"
 	Starting State = "Starting"
+	Paused   State = "Paused"
 	Error    State = "Error"
 )
 
@@ -19,6 +20,8 @@ func FromMachine(input libmachinestate.State) State {
 		return Running
 	case libmachinestate.Stopped:
 		return Stopped
+	case libmachinestate.Paused:
+		return Paused
 	}
 	return Error
 }
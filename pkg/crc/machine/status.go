@@ -2,6 +2,7 @@ package machine
 
 import (
 	"context"
+	"time"
 
 	"github.com/code-ready/crc/pkg/crc/cluster"
 	"github.com/code-ready/crc/pkg/crc/constants"
@@ -9,6 +10,7 @@ import (
 	"github.com/code-ready/crc/pkg/crc/machine/bundle"
 	"github.com/code-ready/crc/pkg/crc/machine/state"
 	"github.com/code-ready/crc/pkg/crc/machine/types"
+	"github.com/code-ready/crc/pkg/crc/network"
 	crcssh "github.com/code-ready/crc/pkg/crc/ssh"
 	libmachinestate "github.com/code-ready/machine/libmachine/state"
 	"github.com/pkg/errors"
@@ -41,6 +43,7 @@ func (client *client) Status() (*types.ClusterStatusResult, error) {
 	}
 
 	if vmStatus != libmachinestate.Running {
+		client.closeStatusRunner()
 		return &types.ClusterStatusResult{
 			CrcStatus:        state.FromMachine(vmStatus),
 			OpenshiftStatus:  types.OpenshiftStopped,
@@ -54,23 +57,34 @@ func (client *client) Status() (*types.ClusterStatusResult, error) {
 	}
 
 	diskSize, diskUse := client.getDiskDetails(ip, crcBundleMetadata)
+	usage := client.getResourceUsage(ip, crcBundleMetadata)
+	resolvValues := client.getResolvValues(ip, crcBundleMetadata)
+	openshiftStatus, operators := getOpenShiftStatus(context.Background(), ip, constants.GetKubeconfigFilePath(client.name))
 	return &types.ClusterStatusResult{
 		CrcStatus:        state.Running,
-		OpenshiftStatus:  getOpenShiftStatus(context.Background(), ip),
+		OpenshiftStatus:  openshiftStatus,
 		OpenshiftVersion: crcBundleMetadata.GetOpenshiftVersion(),
 		DiskUse:          diskUse,
 		DiskSize:         diskSize,
+		RAMUse:           usage.RAMUse,
+		RAMSize:          usage.RAMSize,
+		LoadAverage:      usage.LoadAverage,
+		Pods:             usage.Pods,
+		Containers:       usage.Containers,
+		Operators:        operators,
+		NameServers:      resolvValues.nameServers(),
+		SearchDomains:    resolvValues.searchDomains(),
 	}, nil
 }
 
 func (client *client) getDiskDetails(ip string, bundle *bundle.CrcBundleInfo) (int64, int64) {
-	disk, err, _ := client.diskDetails.Memoize("disks", func() (interface{}, error) {
-		sshRunner, err := crcssh.CreateRunner(ip, getSSHPort(client.useVSock()), constants.GetPrivateKeyPath(), constants.GetRsaPrivateKeyPath(), bundle.GetSSHKeyPath())
-		if err != nil {
-			return nil, errors.Wrap(err, "Error creating the ssh client")
-		}
-		defer sshRunner.Close()
-		diskSize, diskUse, err := cluster.GetRootPartitionUsage(sshRunner)
+	disk, err, _ := client.vmDetails.Memoize("disks", func() (interface{}, error) {
+		var diskSize, diskUse int64
+		err := client.withStatusRunner(ip, bundle, func(sshRunner *crcssh.Runner) error {
+			var err error
+			diskSize, diskUse, err = cluster.GetRootPartitionUsage(sshRunner)
+			return err
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -83,19 +97,120 @@ func (client *client) getDiskDetails(ip string, bundle *bundle.CrcBundleInfo) (i
 	return disk.([]int64)[0], disk.([]int64)[1]
 }
 
-func getOpenShiftStatus(ctx context.Context, ip string) types.OpenshiftStatus {
-	status, err := cluster.GetClusterOperatorsStatus(ctx, ip, constants.KubeconfigFilePath)
+// resourceUsageExpiration is shorter than the refresh interval of 'crc status
+// --watch', the load and the number of pods change between two refreshes
+const resourceUsageExpiration = 3 * time.Second
+
+func (client *client) getResourceUsage(ip string, bundle *bundle.CrcBundleInfo) *cluster.ResourceUsage {
+	usage, err, cached := client.vmDetails.Memoize("usage", func() (interface{}, error) {
+		var usage *cluster.ResourceUsage
+		err := client.withStatusRunner(ip, bundle, func(sshRunner *crcssh.Runner) error {
+			var err error
+			usage, err = cluster.GetResourceUsage(sshRunner)
+			return err
+		})
+		return usage, err
+	})
+	if err != nil {
+		logging.Debugf("Cannot get resource usage: %v", err)
+		return &cluster.ResourceUsage{}
+	}
+	if !cached {
+		client.vmDetails.Storage.Set("usage", usage, resourceUsageExpiration)
+	}
+	return usage.(*cluster.ResourceUsage)
+}
+
+func (client *client) getResolvValues(ip string, bundle *bundle.CrcBundleInfo) *resolvValues {
+	values, err, _ := client.vmDetails.Memoize("resolv", func() (interface{}, error) {
+		var values *network.ResolvFileValues
+		err := client.withStatusRunner(ip, bundle, func(sshRunner *crcssh.Runner) error {
+			var err error
+			values, err = network.GetResolvValuesFromInstance(sshRunner)
+			return err
+		})
+		return values, err
+	})
+	if err != nil {
+		logging.Debugf("Cannot read resolv.conf of the VM: %v", err)
+		return &resolvValues{}
+	}
+	return &resolvValues{values.(*network.ResolvFileValues)}
+}
+
+type resolvValues struct {
+	*network.ResolvFileValues
+}
+
+func (values *resolvValues) nameServers() []string {
+	if values.ResolvFileValues == nil {
+		return nil
+	}
+	var nameservers []string
+	for _, ns := range values.NameServers {
+		nameservers = append(nameservers, ns.IPAddress)
+	}
+	return nameservers
+}
+
+func (values *resolvValues) searchDomains() []string {
+	if values.ResolvFileValues == nil {
+		return nil
+	}
+	var domains []string
+	for _, domain := range values.SearchDomains {
+		domains = append(domains, domain.Domain)
+	}
+	return domains
+}
+
+// withStatusRunner calls fn with the SSH connection shared by the Status
+// calls. It is opened on first use, and closed after a failure since the VM
+// may have been restarted.
+func (client *client) withStatusRunner(ip string, bundle *bundle.CrcBundleInfo, fn func(*crcssh.Runner) error) error {
+	client.statusRunnerLock.Lock()
+	defer client.statusRunnerLock.Unlock()
+
+	if client.statusRunner == nil {
+		sshRunner, err := crcssh.CreateRunner(ip, getSSHPort(client.useVSock()), constants.GetPrivateKeyPath(client.name), constants.GetRsaPrivateKeyPath(client.name), bundle.GetSSHKeyPath())
+		if err != nil {
+			return errors.Wrap(err, "Error creating the ssh client")
+		}
+		client.statusRunner = sshRunner
+	}
+	if err := fn(client.statusRunner); err != nil {
+		client.statusRunner.Close()
+		client.statusRunner = nil
+		return err
+	}
+	return nil
+}
+
+// closeStatusRunner closes the SSH connection of the Status calls, a dead
+// connection would block them
+func (client *client) closeStatusRunner() {
+	client.statusRunnerLock.Lock()
+	defer client.statusRunnerLock.Unlock()
+
+	if client.statusRunner != nil {
+		client.statusRunner.Close()
+		client.statusRunner = nil
+	}
+}
+
+func getOpenShiftStatus(ctx context.Context, ip, kubeconfigFilePath string) (types.OpenshiftStatus, []cluster.OperatorStatus) {
+	status, operators, err := cluster.GetClusterOperators(ctx, ip, kubeconfigFilePath)
 	if err != nil {
 		logging.Debugf("cannot get OpenShift status: %v", err)
-		return types.OpenshiftUnreachable
+		return types.OpenshiftUnreachable, nil
 	}
 	switch {
 	case status.Progressing:
-		return types.OpenshiftStarting
+		return types.OpenshiftStarting, operators
 	case status.Degraded:
-		return types.OpenshiftDegraded
+		return types.OpenshiftDegraded, operators
 	case status.Available:
-		return types.OpenshiftRunning
+		return types.OpenshiftRunning, operators
 	}
-	return types.OpenshiftStopped
+	return types.OpenshiftStopped, operators
 }
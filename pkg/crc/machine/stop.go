@@ -7,10 +7,12 @@ import (
 	"github.com/code-ready/crc/pkg/crc/oc"
 	crcssh "github.com/code-ready/crc/pkg/crc/ssh"
 	"github.com/code-ready/crc/pkg/libmachine/host"
+	libmachinestate "github.com/code-ready/machine/libmachine/state"
 	"github.com/pkg/errors"
 )
 
 func (client *client) Stop() (state.State, error) {
+	client.closeStatusRunner()
 	libMachineAPIClient, cleanup := createLibMachineClient()
 	defer cleanup()
 	host, err := libMachineAPIClient.Load(client.name)
@@ -18,6 +20,18 @@ func (client *client) Stop() (state.State, error) {
 	if err != nil {
 		return state.Error, errors.Wrap(err, "Cannot load machine")
 	}
+	if vmState, err := host.Driver.GetState(); err == nil && vmState == libmachinestate.Paused {
+		logging.Info("Resuming the paused CodeReady Containers VM before stopping it")
+		if err := resumeVM(client.name); err != nil {
+			return state.Paused, err
+		}
+	}
+	if client.useVSock() {
+		// a change of vsock-subnet is applied when the VM boots
+		if err := writeVSockNetwork(host, client); err != nil {
+			logging.Warnf("Cannot update the user mode network configuration of the VM: %v", err)
+		}
+	}
 	if err := removeMCOPods(host, client); err != nil {
 		return state.Error, err
 	}
@@ -46,7 +60,7 @@ func removeMCOPods(host *host.Host, client *client) error {
 	if err != nil {
 		return errors.Wrapf(err, "Error getting the IP")
 	}
-	sshRunner, err := crcssh.CreateRunner(instanceIP, getSSHPort(client.useVSock()), constants.GetPrivateKeyPath(), constants.GetRsaPrivateKeyPath())
+	sshRunner, err := crcssh.CreateRunner(instanceIP, getSSHPort(client.useVSock()), constants.GetPrivateKeyPath(client.name), constants.GetRsaPrivateKeyPath(client.name))
 	if err != nil {
 		return errors.Wrapf(err, "Error creating the ssh client")
 	}
@@ -59,3 +73,17 @@ func removeMCOPods(host *host.Host, client *client) error {
 	}
 	return nil
 }
+
+func writeVSockNetwork(host *host.Host, client *client) error {
+	instanceIP, err := getIP(host, client.useVSock())
+	if err != nil {
+		return errors.Wrapf(err, "Error getting the IP")
+	}
+	sshRunner, err := crcssh.CreateRunner(instanceIP, getSSHPort(client.useVSock()), constants.GetPrivateKeyPath(client.name), constants.GetRsaPrivateKeyPath(client.name))
+	if err != nil {
+		return errors.Wrapf(err, "Error creating the ssh client")
+	}
+	defer sshRunner.Close()
+
+	return configureVSockNetwork(sshRunner, client.name, client.vsockNetwork())
+}
@@ -3,9 +3,11 @@ package machine
 import (
 	"context"
 	"errors"
+	"io"
 	"sync"
 	"time"
 
+	"github.com/code-ready/crc/pkg/crc/cluster"
 	"github.com/code-ready/crc/pkg/crc/logging"
 	"github.com/code-ready/crc/pkg/crc/machine/state"
 	"github.com/code-ready/crc/pkg/crc/machine/types"
@@ -20,14 +22,23 @@ const (
 	Deleting State = "Deleting"
 	Stopping State = "Stopping"
 	Starting State = "Starting"
+	Pausing  State = "Pausing"
+	Resuming State = "Resuming"
+	// Snapshotting is used while a snapshot is saved, restored or deleted
+	Snapshotting State = "Snapshotting"
+	Resizing     State = "Resizing"
+	Compacting   State = "Compacting"
+	// Certificates is used while the certificates are checked or renewed
+	Certificates State = "Certificates"
 )
 
 type Synchronized struct {
 	underlying Client
 
-	stateLock    sync.Mutex
-	currentState State
-	startCancel  context.CancelFunc
+	stateLock      sync.Mutex
+	currentState   State
+	startCancel    context.CancelFunc
+	stateListeners []func(State)
 
 	syncOperationDone chan State
 }
@@ -40,6 +51,32 @@ func NewSynchronizedMachine(machine Client) *Synchronized {
 	}
 }
 
+// AddStateListener registers a function called each time an operation
+// begins or ends. It must not block.
+func (s *Synchronized) AddStateListener(listener func(State)) {
+	s.stateLock.Lock()
+	defer s.stateLock.Unlock()
+	s.stateListeners = append(s.stateListeners, listener)
+}
+
+func (s *Synchronized) notifyStateChange(state State) {
+	s.stateLock.Lock()
+	listeners := s.stateListeners
+	s.stateLock.Unlock()
+
+	for _, listener := range listeners {
+		listener(state)
+	}
+}
+
+// operationDone marks the operation as finished and notifies the listeners of
+// the resulting state, which is not Idle when the operation was cancelled by
+// another one
+func (s *Synchronized) operationDone(state State) {
+	s.syncOperationDone <- state
+	s.notifyStateChange(s.CurrentState())
+}
+
 func (s *Synchronized) CurrentState() State {
 	s.stateLock.Lock()
 	defer s.stateLock.Unlock()
@@ -65,9 +102,10 @@ func (s *Synchronized) Delete() error {
 	if err := s.prepareStopDelete(Deleting); err != nil {
 		return err
 	}
+	s.notifyStateChange(Deleting)
 
 	err := s.underlying.Delete()
-	s.syncOperationDone <- Deleting
+	s.operationDone(Deleting)
 	return err
 }
 
@@ -88,9 +126,10 @@ func (s *Synchronized) Start(ctx context.Context, startConfig types.StartConfig)
 	if err := s.prepareStart(startCancel); err != nil {
 		return nil, err
 	}
+	s.notifyStateChange(Starting)
 
 	startResult, err := s.underlying.Start(ctx, startConfig)
-	s.syncOperationDone <- Starting
+	s.operationDone(Starting)
 	return startResult, err
 }
 
@@ -123,6 +162,16 @@ func (s *Synchronized) prepareStopDelete(state State) error {
 		break
 	case Deleting, Stopping:
 		return errors.New("cluster is stopping or deleting")
+	case Pausing, Resuming:
+		return errors.New("cluster is pausing or resuming")
+	case Snapshotting:
+		return errors.New("a snapshot of the cluster is in progress")
+	case Resizing:
+		return errors.New("cluster is resizing")
+	case Compacting:
+		return errors.New("cluster disk is being compacted")
+	case Certificates:
+		return errors.New("cluster certificates are being checked or renewed")
 	default:
 		return errors.New("invalid condition")
 	}
@@ -135,13 +184,58 @@ func (s *Synchronized) Stop() (state.State, error) {
 	if err := s.prepareStopDelete(Stopping); err != nil {
 		return state.Error, err
 	}
+	s.notifyStateChange(Stopping)
 
 	st, err := s.underlying.Stop()
-	s.syncOperationDone <- Stopping
+	s.operationDone(Stopping)
 
 	return st, err
 }
 
+func (s *Synchronized) prepareIdleOperation(state State) error {
+	s.stateLock.Lock()
+	defer s.stateLock.Unlock()
+	if s.currentStateUnlocked() != Idle {
+		return errors.New("cluster is busy")
+	}
+	s.currentState = state
+
+	return nil
+}
+
+func (s *Synchronized) Pause() error {
+	if err := s.prepareIdleOperation(Pausing); err != nil {
+		return err
+	}
+	s.notifyStateChange(Pausing)
+
+	err := s.underlying.Pause()
+	s.operationDone(Pausing)
+	return err
+}
+
+func (s *Synchronized) Resume() error {
+	if err := s.prepareIdleOperation(Resuming); err != nil {
+		return err
+	}
+	s.notifyStateChange(Resuming)
+
+	err := s.underlying.Resume()
+	s.operationDone(Resuming)
+	return err
+}
+
+func (s *Synchronized) Resize(memory, cpus int) error {
+	if err := s.prepareIdleOperation(Resizing); err != nil {
+		return err
+	}
+	s.notifyStateChange(Resizing)
+
+	err := s.underlying.Resize(memory, cpus)
+	s.operationDone(Resizing)
+	return err
+}
+
 func (s *Synchronized) GetName() string {
 	return s.underlying.GetName()
 }
@@ -186,3 +280,74 @@ func (s *Synchronized) IsRunning() (bool, error) {
 func (s *Synchronized) GenerateBundle(forceStop bool) error {
 	return s.underlying.GenerateBundle(forceStop)
 }
+
+// CompactDisk stops the VM, it holds the lock for the whole operation so that
+// no start or delete runs meanwhile
+func (s *Synchronized) CompactDisk() (int64, error) {
+	if err := s.prepareIdleOperation(Compacting); err != nil {
+		return 0, err
+	}
+	s.notifyStateChange(Compacting)
+
+	reclaimed, err := s.underlying.CompactDisk()
+	s.operationDone(Compacting)
+	return reclaimed, err
+}
+
+func (s *Synchronized) VMLogs(ctx context.Context, unit string, since time.Duration, follow bool, writer io.Writer) error {
+	return s.underlying.VMLogs(ctx, unit, since, follow, writer)
+}
+
+func (s *Synchronized) CertsStatus() ([]cluster.CertExpiry, error) {
+	return s.underlying.CertsStatus()
+}
+
+// RenewCerts restarts the kubelet, it holds the lock for the whole operation
+// so that no stop or delete runs meanwhile
+func (s *Synchronized) RenewCerts() error {
+	if err := s.prepareIdleOperation(Certificates); err != nil {
+		return err
+	}
+	s.notifyStateChange(Certificates)
+
+	err := s.underlying.RenewCerts()
+	s.operationDone(Certificates)
+	return err
+}
+
+func (s *Synchronized) SaveSnapshot(snapshot string) error {
+	if err := s.prepareIdleOperation(Snapshotting); err != nil {
+		return err
+	}
+	s.notifyStateChange(Snapshotting)
+
+	err := s.underlying.SaveSnapshot(snapshot)
+	s.operationDone(Snapshotting)
+	return err
+}
+
+func (s *Synchronized) ListSnapshots() ([]types.SnapshotDetails, error) {
+	return s.underlying.ListSnapshots()
+}
+
+func (s *Synchronized) RestoreSnapshot(snapshot string) error {
+	if err := s.prepareIdleOperation(Snapshotting); err != nil {
+		return err
+	}
+	s.notifyStateChange(Snapshotting)
+
+	err := s.underlying.RestoreSnapshot(snapshot)
+	s.operationDone(Snapshotting)
+	return err
+}
+
+func (s *Synchronized) DeleteSnapshot(snapshot string) error {
+	if err := s.prepareIdleOperation(Snapshotting); err != nil {
+		return err
+	}
+	s.notifyStateChange(Snapshotting)
+
+	err := s.underlying.DeleteSnapshot(snapshot)
+	s.operationDone(Snapshotting)
+	return err
+}
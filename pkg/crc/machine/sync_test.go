@@ -3,9 +3,12 @@ package machine
 import (
 	"context"
 	"errors"
+	"io"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/code-ready/crc/pkg/crc/cluster"
 	"github.com/code-ready/crc/pkg/crc/machine/state"
 	"github.com/code-ready/crc/pkg/crc/machine/types"
 	"github.com/stretchr/testify/assert"
@@ -72,6 +75,41 @@ func TestDeleteStop(t *testing.T) {
 	assert.Equal(t, Idle, syncMachine.CurrentState())
 }
 
+func TestIdleOperationsDuringStart(t *testing.T) {
+	isRunning := make(chan struct{}, 1)
+	startCh := make(chan struct{}, 1)
+	syncMachine := NewSynchronizedMachine(&waitingMachine{
+		isRunning:       isRunning,
+		startCompleteCh: startCh,
+	})
+
+	lock := &sync.WaitGroup{}
+	lock.Add(1)
+	go func() {
+		defer lock.Done()
+		_, err := syncMachine.Start(context.Background(), types.StartConfig{})
+		assert.NoError(t, err)
+	}()
+
+	<-isRunning
+	assert.EqualError(t, syncMachine.SaveSnapshot("test"), "cluster is busy")
+	assert.EqualError(t, syncMachine.RestoreSnapshot("test"), "cluster is busy")
+	assert.EqualError(t, syncMachine.DeleteSnapshot("test"), "cluster is busy")
+	assert.EqualError(t, syncMachine.Resize(8192, 0), "cluster is busy")
+	_, err := syncMachine.CompactDisk()
+	assert.EqualError(t, err, "cluster is busy")
+	assert.EqualError(t, syncMachine.RenewCerts(), "cluster is busy")
+	// reading the certificates does not need the lock
+	_, err = syncMachine.CertsStatus()
+	assert.EqualError(t, err, "not implemented")
+
+	startCh <- struct{}{}
+	lock.Wait()
+
+	assert.EqualError(t, syncMachine.RestoreSnapshot("test"), "not implemented")
+	assert.Equal(t, Idle, syncMachine.CurrentState())
+}
+
 func TestCancelStart(t *testing.T) {
 	isRunning := make(chan struct{}, 1)
 	deleteCh := make(chan struct{}, 1)
@@ -106,6 +144,29 @@ func TestCancelStart(t *testing.T) {
 	assert.Equal(t, Idle, syncMachine.CurrentState())
 }
 
+func TestStateListener(t *testing.T) {
+	isRunning := make(chan struct{}, 1)
+	startCh := make(chan struct{}, 1)
+	syncMachine := NewSynchronizedMachine(&waitingMachine{
+		isRunning:       isRunning,
+		startCompleteCh: startCh,
+	})
+
+	var states []State
+	syncMachine.AddStateListener(func(state State) {
+		states = append(states, state)
+	})
+
+	startCh <- struct{}{}
+	go func() {
+		<-isRunning
+	}()
+	_, err := syncMachine.Start(context.Background(), types.StartConfig{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []State{Starting, Idle}, states)
+}
+
 type waitingMachine struct {
 	isRunning        chan struct{}
 	startCompleteCh  chan struct{}
@@ -166,6 +227,50 @@ func (m *waitingMachine) Stop() (state.State, error) {
 	return state.Stopped, nil
 }
 
+func (m *waitingMachine) Pause() error {
+	return errors.New("not implemented")
+}
+
+func (m *waitingMachine) Resume() error {
+	return errors.New("not implemented")
+}
+
+func (m *waitingMachine) Resize(memory, cpus int) error {
+	return errors.New("not implemented")
+}
+
 func (m *waitingMachine) GenerateBundle(forceStop bool) error {
 	return errors.New("not implemented")
 }
+
+func (m *waitingMachine) CompactDisk() (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (m *waitingMachine) VMLogs(ctx context.Context, unit string, since time.Duration, follow bool, writer io.Writer) error {
+	return errors.New("not implemented")
+}
+
+func (m *waitingMachine) CertsStatus() ([]cluster.CertExpiry, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *waitingMachine) RenewCerts() error {
+	return errors.New("not implemented")
+}
+
+func (m *waitingMachine) SaveSnapshot(snapshot string) error {
+	return errors.New("not implemented")
+}
+
+func (m *waitingMachine) ListSnapshots() ([]types.SnapshotDetails, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *waitingMachine) RestoreSnapshot(snapshot string) error {
+	return errors.New("not implemented")
+}
+
+func (m *waitingMachine) DeleteSnapshot(snapshot string) error {
+	return errors.New("not implemented")
+}
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"time"
+
 	"github.com/code-ready/crc/pkg/crc/cluster"
+	crcConfig "github.com/code-ready/crc/pkg/crc/config"
 	"github.com/code-ready/crc/pkg/crc/machine/state"
 	"github.com/code-ready/crc/pkg/crc/network"
 )
@@ -15,14 +18,67 @@ type StartConfig struct {
 	CPUs     int
 	DiskSize int // Disk size in GiB
 
-	// Nameserver
-	NameServer string
+	// Limits up to which memory and vCPUs can be added to the running VM
+	MaxMemory int // Memory size in MiB
+	MaxCPUs   int
+
+	// Size of the persistent data disk in GiB, 0 when there is none
+	DataDiskSize int
+
+	// Host directories mounted in the VM
+	SharedDirs []crcConfig.SharedDir
+
+	// Nameservers and search domains added to the resolv.conf of the VM
+	NameServers   []network.NameServer
+	SearchDomains []string
 
 	// User Pull secret
 	PullSecret cluster.PullSecretLoader
 
 	// User defined kubeadmin password
 	KubeAdminPassword string
+
+	// Progress is called each time a start phase begins or ends, it may be nil
+	Progress func(StartEvent)
+}
+
+type StartPhase string
+
+const (
+	PhaseValidate         StartPhase = "validate"
+	PhaseCreateVM         StartPhase = "create-vm"
+	PhaseStartVM          StartPhase = "start-vm"
+	PhaseWaitForSSH       StartPhase = "wait-for-ssh"
+	PhaseConfigureVM      StartPhase = "configure-vm"
+	PhaseDNS              StartPhase = "dns"
+	PhaseStartKubelet     StartPhase = "start-kubelet"
+	PhaseWaitForAPIServer StartPhase = "wait-for-apiserver"
+	PhaseConfigureCluster StartPhase = "configure-cluster"
+	PhaseWaitForCluster   StartPhase = "wait-for-cluster"
+	PhaseKubeconfig       StartPhase = "kubeconfig"
+)
+
+type PhaseOutcome string
+
+const (
+	PhaseInProgress PhaseOutcome = "in-progress"
+	PhaseSucceeded  PhaseOutcome = "succeeded"
+	PhaseFailed     PhaseOutcome = "failed"
+	// PhaseWarning is the outcome of a phase which failed without failing
+	// the start
+	PhaseWarning PhaseOutcome = "warning"
+)
+
+// StartEvent describes a phase of the start process. An event is emitted when
+// the phase begins, with the in-progress outcome and a zero EndTime, and a
+// second one when the phase ends.
+type StartEvent struct {
+	Phase       StartPhase
+	Description string
+	StartTime   time.Time
+	EndTime     time.Time
+	Outcome     PhaseOutcome
+	Error       string
 }
 
 type ClusterConfig struct {
@@ -53,6 +109,15 @@ type ClusterStatusResult struct {
 	OpenshiftVersion string
 	DiskUse          int64
 	DiskSize         int64
+	RAMUse           int64
+	RAMSize          int64
+	LoadAverage      []float64
+	Pods             int
+	Containers       int
+	Operators        []cluster.OperatorStatus
+	// read from the resolv.conf of the VM
+	NameServers   []string
+	SearchDomains []string
 }
 
 type OpenshiftStatus string
@@ -77,3 +142,15 @@ type ConnectionDetails struct {
 	SSHUsername string
 	SSHKeys     []string
 }
+
+type InstanceDetails struct {
+	Name             string
+	State            state.State
+	OpenshiftVersion string
+	Bundle           string
+}
+
+type SnapshotDetails struct {
+	Name         string
+	CreationTime time.Time
+}
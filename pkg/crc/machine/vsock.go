@@ -2,17 +2,22 @@ package machine
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
 
 	"github.com/code-ready/crc/pkg/crc/constants"
 	"github.com/code-ready/crc/pkg/crc/daemonclient"
 	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/network"
+	crcssh "github.com/code-ready/crc/pkg/crc/ssh"
 	"github.com/code-ready/gvisor-tap-vsock/pkg/types"
 	"github.com/pkg/errors"
 )
 
-func exposePorts() error {
+func exposePorts(vsockNetwork network.VSockNetwork) error {
 	daemonClient := daemonclient.New()
-	portsToExpose := vsockPorts()
+	portsToExpose := vsockPorts(vsockNetwork.VirtualMachine().String())
 	alreadyOpenedPorts, err := daemonClient.NetworkClient.List()
 	if err != nil {
 		logging.Error("Is 'crc daemon' running? Network mode 'vsock' requires 'crc daemon' to be running, run it manually on different terminal/tab")
@@ -33,6 +38,30 @@ func exposePorts() error {
 	return nil
 }
 
+// InstanceVSockNetwork returns the user mode network the named instance boots
+// with, the one of the bundles until configureVSockNetwork has changed it. The
+// daemon serves this network, the instance would not be reachable with another one.
+func InstanceVSockNetwork(name string) (network.VSockNetwork, error) {
+	data, err := ioutil.ReadFile(constants.GetVSockSubnetPath(name))
+	if os.IsNotExist(err) {
+		return network.DefaultVSockNetwork, nil
+	}
+	if err != nil {
+		return network.VSockNetwork{}, err
+	}
+	return network.ParseVSockSubnet(strings.TrimSpace(string(data)))
+}
+
+// configureVSockNetwork writes the IP configuration of the user mode network in
+// the instance, it is used the next time the instance boots and the daemon is
+// restarted
+func configureVSockNetwork(sshRunner *crcssh.Runner, name string, vsockNetwork network.VSockNetwork) error {
+	if err := network.ConfigureVSockNetworkOnInstance(sshRunner, vsockNetwork); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(constants.GetVSockSubnetPath(name), []byte(vsockNetwork.String()), 0600)
+}
+
 func isOpened(exposed []types.ExposeRequest, port types.ExposeRequest) bool {
 	for _, alreadyOpenedPort := range exposed {
 		if port == alreadyOpenedPort {
@@ -43,14 +72,13 @@ func isOpened(exposed []types.ExposeRequest, port types.ExposeRequest) bool {
 }
 
 const (
-	virtualMachineIP = "192.168.127.2"
-	internalSSHPort  = 22
-	httpPort         = 80
-	httpsPort        = 443
-	apiPort          = 6443
+	internalSSHPort = 22
+	httpPort        = 80
+	httpsPort       = 443
+	apiPort         = 6443
 )
 
-func vsockPorts() []types.ExposeRequest {
+func vsockPorts(virtualMachineIP string) []types.ExposeRequest {
 	return []types.ExposeRequest{
 		{
 			Local:  fmt.Sprintf(":%d", constants.VsockSSHPort),
@@ -0,0 +1,16 @@
+package machine
+
+import (
+	"testing"
+
+	"github.com/code-ready/crc/pkg/crc/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceVSockNetwork(t *testing.T) {
+	// an instance which was never configured boots with the network of the bundles
+	vsockNetwork, err := InstanceVSockNetwork("crc-vsock-test-missing")
+	require.NoError(t, err)
+	assert.Equal(t, network.DefaultVSockNetwork.String(), vsockNetwork.String())
+}
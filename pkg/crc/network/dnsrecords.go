@@ -0,0 +1,89 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/code-ready/crc/pkg/crc/validation"
+)
+
+// DNSRecord is an address record added to the DNS server of the VM
+type DNSRecord struct {
+	Name string `json:"name"`
+	IP   string `json:"ip"`
+}
+
+func (record DNSRecord) String() string {
+	return fmt.Sprintf("%s=%s", record.Name, record.IP)
+}
+
+// ParseDNSRecords parses a comma separated list of <name>=<ip> pairs
+func ParseDNSRecords(value string) ([]DNSRecord, error) {
+	var records []DNSRecord
+	names := map[string]bool{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		fields := strings.SplitN(entry, "=", 2)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' must be of the form <name>=<ip>", entry)
+		}
+		record, err := NewDNSRecord(fields[0], fields[1])
+		if err != nil {
+			return nil, err
+		}
+		if names[record.Name] {
+			return nil, fmt.Errorf("more than one record for '%s'", record.Name)
+		}
+		names[record.Name] = true
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// NewDNSRecord validates the name and the IPv4 or IPv6 address of a record
+func NewDNSRecord(name, ip string) (DNSRecord, error) {
+	name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+	if err := validation.ValidateHostname(name); err != nil {
+		return DNSRecord{}, err
+	}
+	parsedIP := net.ParseIP(strings.TrimSpace(ip))
+	if parsedIP == nil {
+		return DNSRecord{}, fmt.Errorf("'%s' is not a valid IP address", ip)
+	}
+	return DNSRecord{Name: name, IP: parsedIP.String()}, nil
+}
+
+// FormatDNSRecords is the reverse of ParseDNSRecords
+func FormatDNSRecords(records []DNSRecord) string {
+	var entries []string
+	for _, record := range records {
+		entries = append(entries, record.String())
+	}
+	return strings.Join(entries, ",")
+}
+
+// ParseUpstreamDNS parses a comma separated list of IP addresses of DNS servers
+func ParseUpstreamDNS(value string) ([]string, error) {
+	return parseIPList(value)
+}
+
+// parseIPList parses a comma separated list of IPv4 and IPv6 addresses
+func parseIPList(value string) ([]string, error) {
+	var servers []string
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		ip := net.ParseIP(entry)
+		if ip == nil {
+			return nil, fmt.Errorf("'%s' is not a valid IP address", entry)
+		}
+		servers = append(servers, ip.String())
+	}
+	return servers, nil
+}
@@ -0,0 +1,40 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDNSRecords(t *testing.T) {
+	records, err := ParseDNSRecords(" registry.staging.example.com=10.10.2.5, Git.=fd00:0::5,")
+	require.NoError(t, err)
+	assert.Equal(t, []DNSRecord{
+		{Name: "registry.staging.example.com", IP: "10.10.2.5"},
+		{Name: "git", IP: "fd00::5"},
+	}, records)
+	assert.Equal(t, "registry.staging.example.com=10.10.2.5,git=fd00::5", FormatDNSRecords(records))
+
+	records, err = ParseDNSRecords("")
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	_, err = ParseDNSRecords("registry")
+	assert.EqualError(t, err, "'registry' must be of the form <name>=<ip>")
+	_, err = ParseDNSRecords("registry=10.10.2.5,registry=10.10.2.6")
+	assert.EqualError(t, err, "more than one record for 'registry'")
+	_, err = ParseDNSRecords("registry_1=10.10.2.5")
+	assert.Error(t, err)
+	_, err = ParseDNSRecords("registry=example.com")
+	assert.EqualError(t, err, "'example.com' is not a valid IP address")
+}
+
+func TestParseUpstreamDNS(t *testing.T) {
+	servers, err := ParseUpstreamDNS("10.10.0.53, fd00:0::53")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.10.0.53", "fd00::53"}, servers)
+
+	_, err = ParseUpstreamDNS("10.10.0.53:5353")
+	assert.EqualError(t, err, "'10.10.0.53:5353' is not a valid IP address")
+}
@@ -6,18 +6,36 @@ import (
 	"strings"
 
 	"github.com/code-ready/crc/pkg/crc/ssh"
+	"github.com/code-ready/crc/pkg/crc/validation"
 )
 
-// HasGivenNameserversConfigured returns true if the instance uses a provided nameserver.
-func HasGivenNameserversConfigured(sshRunner *ssh.Runner, nameserver NameServer) (bool, error) {
-	cmd := "cat /etc/resolv.conf"
-	out, _, err := sshRunner.Run(cmd)
-
+// ParseNameServers parses a comma separated list of IPv4 and IPv6 addresses
+func ParseNameServers(value string) ([]NameServer, error) {
+	ips, err := parseIPList(value)
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+	var nameservers []NameServer
+	for _, ip := range ips {
+		nameservers = append(nameservers, NameServer{IPAddress: ip})
 	}
+	return nameservers, nil
+}
 
-	return strings.Contains(out, nameserver.IPAddress), nil
+// ParseSearchDomains parses a comma separated list of DNS domains
+func ParseSearchDomains(value string) ([]string, error) {
+	var domains []string
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(entry)), ".")
+		if entry == "" {
+			continue
+		}
+		if err := validation.ValidateHostname(entry); err != nil {
+			return nil, err
+		}
+		domains = append(domains, entry)
+	}
+	return domains, nil
 }
 
 func GetResolvValuesFromInstance(sshRunner *ssh.Runner) (*ResolvFileValues, error) {
@@ -41,26 +59,6 @@ func CreateResolvFileOnInstance(sshRunner *ssh.Runner, resolvFileValues ResolvFi
 	return nil
 }
 
-// AddNameserversToInstance will add additional nameservers to the end of the
-// /etc/resolv.conf file inside the instance.
-func AddNameserversToInstance(sshRunner *ssh.Runner, nameservers []NameServer) error {
-	for _, ns := range nameservers {
-		if err := addNameserverToInstance(sshRunner, ns); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// writes nameserver to the /etc/resolv.conf inside the instance
-func addNameserverToInstance(sshRunner *ssh.Runner, nameserver NameServer) error {
-	_, _, err := sshRunner.Run(fmt.Sprintf("NS=%s; cat /etc/resolv.conf |grep -i \"^nameserver $NS\" || echo \"nameserver $NS\" | sudo tee -a /etc/resolv.conf", nameserver.IPAddress))
-	if err != nil {
-		return fmt.Errorf("%s: %s", "Error adding nameserver", err.Error())
-	}
-	return nil
-}
-
 func GetResolvValuesFromHost() (*ResolvFileValues, error) {
 	// TODO: we need to add runtime OS in case of windows.
 	out, err := ioutil.ReadFile("/etc/resolv.conf")
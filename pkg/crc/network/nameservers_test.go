@@ -0,0 +1,48 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNameServers(t *testing.T) {
+	nameservers, err := ParseNameServers("10.10.0.53, fd00:0::53,")
+	require.NoError(t, err)
+	assert.Equal(t, []NameServer{{IPAddress: "10.10.0.53"}, {IPAddress: "fd00::53"}}, nameservers)
+
+	_, err = ParseNameServers("ns1.example.com")
+	assert.EqualError(t, err, "'ns1.example.com' is not a valid IP address")
+}
+
+func TestParseSearchDomains(t *testing.T) {
+	domains, err := ParseSearchDomains(" Corp.Example.com., lab.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"corp.example.com", "lab.example.com"}, domains)
+
+	domains, err = ParseSearchDomains("")
+	assert.NoError(t, err)
+	assert.Empty(t, domains)
+
+	_, err = ParseSearchDomains("lab_1.example.com")
+	assert.Error(t, err)
+}
+
+func TestResolvFileWithSearchDomains(t *testing.T) {
+	resolvFile, err := CreateResolvFile(ResolvFileValues{
+		SearchDomains: []SearchDomain{{Domain: "crc.testing"}, {Domain: "corp.example.com"}},
+		NameServers:   []NameServer{{IPAddress: "10.88.0.8"}, {IPAddress: "fd00::53"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `# Generated by CRC
+search crc.testing corp.example.com
+nameserver 10.88.0.8
+nameserver fd00::53
+
+`, resolvFile)
+
+	values, err := parseResolveConfFile(resolvFile)
+	require.NoError(t, err)
+	assert.Equal(t, []SearchDomain{{Domain: "crc.testing"}, {Domain: "corp.example.com"}}, values.SearchDomains)
+}
@@ -2,10 +2,12 @@ package network
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
 
 	"github.com/asaskevich/govalidator"
+	"github.com/code-ready/crc/pkg/crc/logging"
 )
 
 var (
@@ -19,6 +21,10 @@ type ProxyConfig struct {
 	HTTPSProxy  string
 	noProxy     []string
 	ProxyCACert string
+
+	// credentials of 'crc proxy login', they are only added to the proxy URLs
+	// given to the host transport and to the cluster
+	credentials *url.Userinfo
 }
 
 func NewProxyDefaults(httpProxy, httpsProxy, noProxy, proxyCACert string) (*ProxyConfig, error) {
@@ -39,6 +45,15 @@ func NewProxyDefaults(httpProxy, httpsProxy, noProxy, proxyCACert string) (*Prox
 	}
 	DefaultProxy.setNoProxyString(noProxy)
 
+	// the keyring is only read when a proxy is used
+	if DefaultProxy.IsEnabled() {
+		credentials, err := LoadProxyCredentialsFromKeyring()
+		if err != nil {
+			logging.Debugf("Cannot load the proxy credentials from the keyring: %v", err)
+		}
+		DefaultProxy.credentials = credentials
+	}
+
 	return NewProxyConfig()
 }
 
@@ -49,6 +64,7 @@ func NewProxyConfig() (*ProxyConfig, error) {
 		HTTPProxy:   DefaultProxy.HTTPProxy,
 		HTTPSProxy:  DefaultProxy.HTTPSProxy,
 		ProxyCACert: DefaultProxy.ProxyCACert,
+		credentials: DefaultProxy.credentials,
 	}
 
 	config.noProxy = defaultNoProxies
@@ -89,6 +105,30 @@ func (p *ProxyConfig) HTTPSProxyForDisplay() string {
 	return httpsProxy
 }
 
+// HTTPProxyWithCredentials returns the HTTP proxy URL with the credentials of
+// 'crc proxy login', unless the URL has its own credentials
+func (p *ProxyConfig) HTTPProxyWithCredentials() string {
+	return p.withCredentials(p.HTTPProxy)
+}
+
+// HTTPSProxyWithCredentials returns the HTTPS proxy URL with the credentials
+// of 'crc proxy login', unless the URL has its own credentials
+func (p *ProxyConfig) HTTPSProxyWithCredentials() string {
+	return p.withCredentials(p.HTTPSProxy)
+}
+
+func (p *ProxyConfig) withCredentials(proxyURL string) string {
+	if p.credentials == nil || proxyURL == "" {
+		return proxyURL
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.User != nil {
+		return proxyURL
+	}
+	u.User = p.credentials
+	return u.String()
+}
+
 // AddNoProxy appends the specified host to the list of no proxied hosts.
 func (p *ProxyConfig) AddNoProxy(host ...string) {
 	p.noProxy = append(p.noProxy, host...)
@@ -112,12 +152,12 @@ func (p *ProxyConfig) ApplyToEnvironment() {
 	}
 
 	if p.HTTPProxy != "" {
-		os.Setenv("HTTP_PROXY", p.HTTPProxy)
-		os.Setenv("http_proxy", p.HTTPProxy)
+		os.Setenv("HTTP_PROXY", p.HTTPProxyWithCredentials())
+		os.Setenv("http_proxy", p.HTTPProxyWithCredentials())
 	}
 	if p.HTTPSProxy != "" {
-		os.Setenv("HTTPS_PROXY", p.HTTPSProxy)
-		os.Setenv("https_proxy", p.HTTPSProxy)
+		os.Setenv("HTTPS_PROXY", p.HTTPSProxyWithCredentials())
+		os.Setenv("https_proxy", p.HTTPSProxyWithCredentials())
 	}
 	if len(p.noProxy) != 0 {
 		os.Setenv("NO_PROXY", p.GetNoProxyString())
@@ -0,0 +1,43 @@
+package network
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/code-ready/crc/pkg/crc/keyring"
+)
+
+const proxyCredentialsKeyring = "proxy-credentials"
+
+// StoreProxyCredentialsInKeyring stores the user and password of the proxy in
+// the keyring of the system, they are added to the proxy URLs without
+// credentials
+func StoreProxyCredentialsInKeyring(username, password string) error {
+	if username == "" {
+		return fmt.Errorf("The proxy username cannot be empty")
+	}
+	return keyring.Set(proxyCredentialsKeyring, url.UserPassword(username, password).String())
+}
+
+// LoadProxyCredentialsFromKeyring returns the credentials stored by 'crc proxy
+// login', or nil if there are none
+func LoadProxyCredentialsFromKeyring() (*url.Userinfo, error) {
+	credentials, err := keyring.Get(proxyCredentialsKeyring)
+	if err == keyring.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(fmt.Sprintf("http://%s@proxy", credentials))
+	if err != nil || u.User == nil {
+		return nil, fmt.Errorf("Invalid proxy credentials in the keyring")
+	}
+	return u.User, nil
+}
+
+// DeleteProxyCredentialsFromKeyring removes the stored credentials, it returns
+// false if there were none
+func DeleteProxyCredentialsFromKeyring() (bool, error) {
+	return keyring.Delete(proxyCredentialsKeyring)
+}
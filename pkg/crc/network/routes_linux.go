@@ -0,0 +1,55 @@
+package network
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+// hostRoutes returns the IPv4 routes of the main routing table
+func hostRoutes() ([]*net.IPNet, error) {
+	file, err := os.Open("/proc/net/route")
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return parseProcNetRoute(bufio.NewScanner(file))
+}
+
+// parseProcNetRoute parses the content of /proc/net/route, the destination and
+// the mask of the routes are hexadecimal numbers in host byte order
+func parseProcNetRoute(scanner *bufio.Scanner) ([]*net.IPNet, error) {
+	var routes []*net.IPNet
+	// skip the header
+	scanner.Scan()
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 8 {
+			continue
+		}
+		destination, err := parseProcNetRouteIP(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		mask, err := parseProcNetRouteIP(fields[7])
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, &net.IPNet{IP: destination, Mask: net.IPMask(mask)})
+	}
+	return routes, scanner.Err()
+}
+
+func parseProcNetRouteIP(field string) (net.IP, error) {
+	b, err := hex.DecodeString(field)
+	if err != nil || len(b) != net.IPv4len {
+		return nil, fmt.Errorf("invalid address %s in /proc/net/route", field)
+	}
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, binary.LittleEndian.Uint32(b))
+	return ip, nil
+}
@@ -0,0 +1,25 @@
+package network
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const procNetRoute = `Iface	Destination	Gateway 	Flags	RefCnt	Use	Metric	Mask		MTU	Window	IRTT
+wlp3s0	00000000	0101A8C0	0003	0	0	600	00000000	0	0	0
+tun0	00000A0A	00000000	0001	0	0	50	0000FFFF	0	0	0
+wlp3s0	0001A8C0	00000000	0001	0	0	600	00FFFFFF	0	0	0
+`
+
+func TestParseProcNetRoute(t *testing.T) {
+	routes, err := parseProcNetRoute(bufio.NewScanner(strings.NewReader(procNetRoute)))
+	require.NoError(t, err)
+	require.Len(t, routes, 3)
+	assert.Equal(t, "0.0.0.0/0", routes[0].String())
+	assert.Equal(t, "10.10.0.0/16", routes[1].String())
+	assert.Equal(t, "192.168.1.0/24", routes[2].String())
+}
@@ -0,0 +1,25 @@
+// +build !linux
+
+package network
+
+import (
+	"net"
+)
+
+// hostRoutes returns the subnets of the addresses of the host interfaces, they
+// are the routes the host has to the networks it is directly connected to
+func hostRoutes() ([]*net.IPNet, error) {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil, err
+	}
+	var routes []*net.IPNet
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.To4() == nil || ipNet.IP.IsLoopback() {
+			continue
+		}
+		routes = append(routes, &net.IPNet{IP: ipNet.IP.Mask(ipNet.Mask), Mask: ipNet.Mask})
+	}
+	return routes, nil
+}
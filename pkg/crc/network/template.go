@@ -7,7 +7,7 @@ import (
 
 const (
 	resolvFileTemplate = `# Generated by CRC
-{{ range .SearchDomains }}search {{ .Domain }}{{ end }}
+{{ if .SearchDomains }}search{{ range .SearchDomains }} {{ .Domain }}{{ end }}{{ end }}
 {{ range .NameServers }}nameserver {{ .IPAddress }}
 {{ end }}
 `
@@ -3,6 +3,7 @@ package network
 import (
 	"fmt"
 
+	"github.com/code-ready/crc/pkg/crc/constants"
 	"github.com/code-ready/crc/pkg/crc/logging"
 	"github.com/spf13/cast"
 )
@@ -20,6 +21,31 @@ type ResolvFileValues struct {
 	NameServers   []NameServer
 }
 
+// Domains are the DNS domains of the OpenShift API server and of the routes
+// of the applications
+type Domains struct {
+	Cluster string
+	Apps    string
+}
+
+// DefaultDomains are the domains the bundles are built with
+var DefaultDomains = Domains{
+	Cluster: constants.DefaultClusterDomain,
+	Apps:    constants.DefaultAppsDomain,
+}
+
+// Custom returns the apps and cluster domains which differ from the default ones
+func (domains Domains) Custom() []string {
+	var custom []string
+	if domains.Apps != "" && domains.Apps != DefaultDomains.Apps {
+		custom = append(custom, domains.Apps)
+	}
+	if domains.Cluster != "" && domains.Cluster != DefaultDomains.Cluster {
+		custom = append(custom, domains.Cluster)
+	}
+	return custom
+}
+
 type Mode string
 
 const (
@@ -16,7 +16,7 @@ func URIStringForDisplay(uri string) (string, error) {
 		return "", err
 	}
 	if u.User != nil {
-		return fmt.Sprintf("%s://%s:xxx@%s", u.Scheme, u.User.Username(), u.Host), nil
+		return fmt.Sprintf("%s://xxx:xxx@%s", u.Scheme, u.Host), nil
 	}
 	return uri, nil
 }
@@ -55,7 +55,7 @@ func CheckCRCLocalDNSReachableFromHost(bundle *bundle.CrcBundleInfo, expectedIP
 			if runtime.GOOS == "windows" {
 				return err
 			}
-			logging.Warnf("Wildcard DNS resolution for %s does not appear to be working", bundle.ClusterInfo.AppsDomain)
+			logging.Warnf("Wildcard DNS resolution for %s does not appear to be working", bundle.GetAppsDomain())
 			return nil
 		}
 		logging.Debugf("%s resolved to %s", appsHostname, ip)
@@ -0,0 +1,142 @@
+package network
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/code-ready/crc/pkg/crc/constants"
+	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/ssh"
+)
+
+const (
+	// vsockInterface is the tap interface created in the VM by the forwarder
+	// of the user mode network
+	vsockInterface = "tap0"
+
+	vsockConnectionPath = "/etc/NetworkManager/system-connections/crc-vsock.nmconnection"
+)
+
+// VSockNetwork is the virtual network of the user mode networking. The first
+// address of the subnet is the gateway, the second one the VM and the last
+// one is used to reach the host.
+type VSockNetwork struct {
+	subnet *net.IPNet
+}
+
+// DefaultVSockNetwork is the virtual network the bundles are configured with
+var DefaultVSockNetwork, _ = ParseVSockSubnet(constants.DefaultVSockSubnet)
+
+// ParseVSockSubnet parses an IPv4 subnet in CIDR notation, it must have room
+// for the gateway, the VM and the host addresses
+func ParseVSockSubnet(subnet string) (VSockNetwork, error) {
+	ip, ipNet, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return VSockNetwork{}, err
+	}
+	if ip.To4() == nil {
+		return VSockNetwork{}, fmt.Errorf("%s is not an IPv4 subnet", subnet)
+	}
+	if !ip.Equal(ipNet.IP) {
+		return VSockNetwork{}, fmt.Errorf("%s is not the address of the subnet, use %s", ip, ipNet)
+	}
+	if ones, _ := ipNet.Mask.Size(); ones > 29 {
+		return VSockNetwork{}, fmt.Errorf("subnet %s is too small, the prefix length must be at most 29", subnet)
+	}
+	ipNet.IP = ipNet.IP.To4()
+	return VSockNetwork{subnet: ipNet}, nil
+}
+
+func (n VSockNetwork) Subnet() *net.IPNet {
+	return n.subnet
+}
+
+func (n VSockNetwork) String() string {
+	return n.subnet.String()
+}
+
+func (n VSockNetwork) PrefixLength() int {
+	ones, _ := n.subnet.Mask.Size()
+	return ones
+}
+
+func (n VSockNetwork) Gateway() net.IP {
+	return n.nth(1)
+}
+
+func (n VSockNetwork) VirtualMachine() net.IP {
+	return n.nth(2)
+}
+
+func (n VSockNetwork) Host() net.IP {
+	ones, bits := n.subnet.Mask.Size()
+	return n.nth(uint32(1)<<uint(bits-ones) - 2)
+}
+
+func (n VSockNetwork) nth(i uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(n.subnet.IP)+i)
+	return ip
+}
+
+// Overlaps returns true if the two subnets have addresses in common
+func Overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// CheckSubnetAgainstHostRoutes returns an error if the subnet overlaps one of
+// the routes of the host, the default routes excepted
+func CheckSubnetAgainstHostRoutes(subnet *net.IPNet) error {
+	routes, err := hostRoutes()
+	if err != nil {
+		logging.Debugf("Cannot list the routes of the host: %v", err)
+		return nil
+	}
+	for _, route := range routes {
+		if ones, _ := route.Mask.Size(); ones == 0 {
+			continue
+		}
+		if Overlaps(subnet, route) {
+			return fmt.Errorf("%s overlaps the %s route of the host", subnet, route)
+		}
+	}
+	return nil
+}
+
+func vsockConnection(n VSockNetwork) string {
+	return strings.Join([]string{
+		"[connection]",
+		"id=crc-vsock",
+		"type=tun",
+		"interface-name=" + vsockInterface,
+		"",
+		"[tun]",
+		"mode=2",
+		"",
+		"[ipv4]",
+		"method=manual",
+		fmt.Sprintf("address1=%s/%d,%s", n.VirtualMachine(), n.PrefixLength(), n.Gateway()),
+		fmt.Sprintf("dns=%s;", n.Gateway()),
+		"",
+		"[ipv6]",
+		"method=disabled",
+		"",
+	}, "\n")
+}
+
+// ConfigureVSockNetworkOnInstance writes the IP configuration of the tap
+// interface of the user mode network in the instance. It is applied when the
+// instance boots, the current connection is not modified.
+func ConfigureVSockNetworkOnInstance(sshRunner *ssh.Runner, n VSockNetwork) error {
+	connection := vsockConnection(n)
+	current, _, err := sshRunner.RunPrivileged("Reading the user mode network configuration", "cat", vsockConnectionPath)
+	if err == nil && current == connection {
+		return nil
+	}
+	if err := sshRunner.CopyData([]byte(connection), vsockConnectionPath, 0600); err != nil {
+		return fmt.Errorf("Error writing %s on instance: %v", vsockConnectionPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package network
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultVSockNetwork(t *testing.T) {
+	assert.Equal(t, "192.168.127.0/24", DefaultVSockNetwork.String())
+	assert.Equal(t, "192.168.127.1", DefaultVSockNetwork.Gateway().String())
+	assert.Equal(t, "192.168.127.2", DefaultVSockNetwork.VirtualMachine().String())
+	assert.Equal(t, "192.168.127.254", DefaultVSockNetwork.Host().String())
+}
+
+func TestParseVSockSubnet(t *testing.T) {
+	vsockNetwork, err := ParseVSockSubnet("10.88.0.0/16")
+	require.NoError(t, err)
+	assert.Equal(t, 16, vsockNetwork.PrefixLength())
+	assert.Equal(t, "10.88.0.1", vsockNetwork.Gateway().String())
+	assert.Equal(t, "10.88.0.2", vsockNetwork.VirtualMachine().String())
+	assert.Equal(t, "10.88.255.254", vsockNetwork.Host().String())
+
+	vsockNetwork, err = ParseVSockSubnet("172.30.5.8/29")
+	require.NoError(t, err)
+	assert.Equal(t, "172.30.5.14", vsockNetwork.Host().String())
+
+	_, err = ParseVSockSubnet("172.30.5.8/30")
+	assert.EqualError(t, err, "subnet 172.30.5.8/30 is too small, the prefix length must be at most 29")
+	_, err = ParseVSockSubnet("192.168.127.1/24")
+	assert.EqualError(t, err, "192.168.127.1 is not the address of the subnet, use 192.168.127.0/24")
+	_, err = ParseVSockSubnet("fd00::/64")
+	assert.EqualError(t, err, "fd00::/64 is not an IPv4 subnet")
+	_, err = ParseVSockSubnet("192.168.127.0")
+	assert.Error(t, err)
+}
+
+func TestOverlaps(t *testing.T) {
+	parse := func(cidr string) *net.IPNet {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		require.NoError(t, err)
+		return ipNet
+	}
+	assert.True(t, Overlaps(parse("192.168.127.0/24"), parse("192.168.0.0/16")))
+	assert.True(t, Overlaps(parse("192.168.0.0/16"), parse("192.168.127.128/25")))
+	assert.False(t, Overlaps(parse("192.168.127.0/24"), parse("192.168.126.0/24")))
+}
+
+func TestVSockConnection(t *testing.T) {
+	vsockNetwork, err := ParseVSockSubnet("10.88.0.0/16")
+	require.NoError(t, err)
+	assert.Equal(t, `[connection]
+id=crc-vsock
+type=tun
+interface-name=tap0
+
+[tun]
+mode=2
+
+[ipv4]
+method=manual
+address1=10.88.0.2/16,10.88.0.1
+dns=10.88.0.1;
+
+[ipv6]
+method=disabled
+`, vsockConnection(vsockNetwork))
+}
@@ -22,14 +22,14 @@ type Config struct {
 	Timeout          string
 }
 
-// UseOcWithConfig return the oc executable along with valid kubeconfig
+// UseOcWithConfig return the oc executable along with valid kubeconfig.
+// The cluster is the one of the context, it does not depend on the instance name.
 func UseOCWithConfig(machineName string) Config {
 	return Config{
 		Runner:           crcos.NewLocalCommandRunner(),
 		OcExecutablePath: filepath.Join(constants.CrcOcBinDir, constants.OcExecutableName),
-		KubeconfigPath:   filepath.Join(constants.MachineInstanceDir, machineName, "kubeconfig"),
+		KubeconfigPath:   constants.GetKubeconfigFilePath(machineName),
 		Context:          constants.DefaultContext,
-		Cluster:          constants.DefaultName,
 		Timeout:          defaultTimeout,
 	}
 }
@@ -81,7 +81,6 @@ func UseOCWithSSH(sshRunner *ssh.Runner) Config {
 		OcExecutablePath: "oc",
 		KubeconfigPath:   "/opt/kubeconfig",
 		Context:          constants.DefaultContext,
-		Cluster:          constants.DefaultName,
 		Timeout:          defaultTimeout,
 	}
 }
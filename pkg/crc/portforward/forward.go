@@ -0,0 +1,142 @@
+package portforward
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Forward maps a port of the host to a port of the VM, or to a port of a
+// service of the cluster when Service is set
+type Forward struct {
+	HostPort    int    `json:"hostPort"`
+	VMPort      int    `json:"vmPort,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Service     string `json:"service,omitempty"`
+	ServicePort int    `json:"servicePort,omitempty"`
+}
+
+// Target describes the destination of the forwarded connections
+func (forward Forward) Target() string {
+	if forward.Service != "" {
+		return fmt.Sprintf("%s/%s:%d", forward.Namespace, forward.Service, forward.ServicePort)
+	}
+	return strconv.Itoa(forward.VMPort)
+}
+
+// String returns the forward in the format accepted by Parse
+func (forward Forward) String() string {
+	return fmt.Sprintf("%d:%s", forward.HostPort, forward.Target())
+}
+
+// Validate checks the forward has the same constraints as the ones read by
+// Parse, so that it can be stored and parsed again
+func (forward Forward) Validate() error {
+	parsed, err := Parse(forward.String())
+	if err != nil {
+		return err
+	}
+	if parsed != forward {
+		return fmt.Errorf("'%s' must either have a VM port or a service", forward)
+	}
+	return nil
+}
+
+// Parse parses <host-port>:<vm-port> and <host-port>:<namespace>/<service>:<port>
+func Parse(spec string) (Forward, error) {
+	parts := strings.SplitN(strings.TrimSpace(spec), ":", 2)
+	if len(parts) != 2 {
+		return Forward{}, fmt.Errorf("'%s' must be of the form <host-port>:<vm-port> or <host-port>:<namespace>/<service>:<port>", spec)
+	}
+	hostPort, err := parsePort(parts[0])
+	if err != nil {
+		return Forward{}, err
+	}
+	target := parts[1]
+	if !strings.Contains(target, "/") {
+		vmPort, err := parsePort(target)
+		if err != nil {
+			return Forward{}, err
+		}
+		return Forward{HostPort: hostPort, VMPort: vmPort}, nil
+	}
+
+	index := strings.LastIndex(target, ":")
+	if index == -1 {
+		return Forward{}, fmt.Errorf("service '%s' must be of the form <namespace>/<service>:<port>", target)
+	}
+	servicePort, err := parsePort(target[index+1:])
+	if err != nil {
+		return Forward{}, err
+	}
+	names := strings.Split(target[:index], "/")
+	if len(names) != 2 || names[0] == "" || names[1] == "" {
+		return Forward{}, fmt.Errorf("service '%s' must be of the form <namespace>/<service>:<port>", target)
+	}
+	return Forward{
+		HostPort:    hostPort,
+		Namespace:   names[0],
+		Service:     names[1],
+		ServicePort: servicePort,
+	}, nil
+}
+
+func parsePort(value string) (int, error) {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("'%s' is not a valid port number", value)
+	}
+	return port, nil
+}
+
+// ParseList parses a comma separated list of forwards
+func ParseList(value string) ([]Forward, error) {
+	var forwards []Forward
+	hostPorts := map[int]bool{}
+	for _, spec := range strings.Split(value, ",") {
+		if strings.TrimSpace(spec) == "" {
+			continue
+		}
+		forward, err := Parse(spec)
+		if err != nil {
+			return nil, err
+		}
+		if hostPorts[forward.HostPort] {
+			return nil, fmt.Errorf("host port %d is forwarded more than once", forward.HostPort)
+		}
+		hostPorts[forward.HostPort] = true
+		forwards = append(forwards, forward)
+	}
+	return forwards, nil
+}
+
+// FormatList is the reverse of ParseList
+func FormatList(forwards []Forward) string {
+	var specs []string
+	for _, forward := range forwards {
+		specs = append(specs, forward.String())
+	}
+	return strings.Join(specs, ",")
+}
+
+// AddToList adds a forward to a list sorted by host port
+func AddToList(forwards []Forward, forward Forward) []Forward {
+	forwards = append(forwards, forward)
+	sort.SliceStable(forwards, func(i, j int) bool {
+		return forwards[i].HostPort < forwards[j].HostPort
+	})
+	return forwards
+}
+
+// RemoveFromList removes the forward of a host port from a list and tells
+// whether the list had one
+func RemoveFromList(forwards []Forward, hostPort int) ([]Forward, bool) {
+	var kept []Forward
+	for _, forward := range forwards {
+		if forward.HostPort != hostPort {
+			kept = append(kept, forward)
+		}
+	}
+	return kept, len(kept) != len(forwards)
+}
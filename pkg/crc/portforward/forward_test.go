@@ -0,0 +1,65 @@
+package portforward
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	forward, err := Parse("8080:80")
+	require.NoError(t, err)
+	assert.Equal(t, Forward{HostPort: 8080, VMPort: 80}, forward)
+	assert.Equal(t, "8080:80", forward.String())
+
+	forward, err = Parse("15432:db/postgres:5432")
+	require.NoError(t, err)
+	assert.Equal(t, Forward{HostPort: 15432, Namespace: "db", Service: "postgres", ServicePort: 5432}, forward)
+	assert.Equal(t, "15432:db/postgres:5432", forward.String())
+
+	_, err = Parse("8080")
+	assert.EqualError(t, err, "'8080' must be of the form <host-port>:<vm-port> or <host-port>:<namespace>/<service>:<port>")
+	_, err = Parse("8080:70000")
+	assert.EqualError(t, err, "'70000' is not a valid port number")
+	_, err = Parse("8080:postgres/5432")
+	assert.EqualError(t, err, "service 'postgres/5432' must be of the form <namespace>/<service>:<port>")
+	_, err = Parse("8080:/postgres:5432")
+	assert.EqualError(t, err, "service '/postgres:5432' must be of the form <namespace>/<service>:<port>")
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Forward{HostPort: 8080, VMPort: 80}.Validate())
+	assert.NoError(t, Forward{HostPort: 15432, Namespace: "db", Service: "postgres", ServicePort: 5432}.Validate())
+
+	assert.EqualError(t, Forward{VMPort: 80}.Validate(), "'0' is not a valid port number")
+	assert.EqualError(t, Forward{HostPort: 8080}.Validate(), "'0' is not a valid port number")
+	assert.EqualError(t, Forward{HostPort: 8080, Service: "postgres", ServicePort: 5432}.Validate(), "service '/postgres:5432' must be of the form <namespace>/<service>:<port>")
+	assert.EqualError(t, Forward{HostPort: 8080, VMPort: 80, Namespace: "db", Service: "postgres", ServicePort: 5432}.Validate(), "'8080:db/postgres:5432' must either have a VM port or a service")
+}
+
+func TestParseList(t *testing.T) {
+	forwards, err := ParseList("8080:80, 15432:db/postgres:5432")
+	require.NoError(t, err)
+	assert.Len(t, forwards, 2)
+	assert.Equal(t, "8080:80,15432:db/postgres:5432", FormatList(forwards))
+
+	forwards, err = ParseList("")
+	assert.NoError(t, err)
+	assert.Empty(t, forwards)
+
+	_, err = ParseList("8080:80,8080:81")
+	assert.EqualError(t, err, "host port 8080 is forwarded more than once")
+}
+
+func TestAddToAndRemoveFromList(t *testing.T) {
+	forwards := AddToList([]Forward{{HostPort: 8080, VMPort: 80}, {HostPort: 9090, VMPort: 90}}, Forward{HostPort: 8443, VMPort: 443})
+	assert.Equal(t, "8080:80,8443:443,9090:90", FormatList(forwards))
+
+	forwards, removed := RemoveFromList(forwards, 8443)
+	assert.True(t, removed)
+	assert.Equal(t, "8080:80,9090:90", FormatList(forwards))
+
+	_, removed = RemoveFromList(forwards, 8443)
+	assert.False(t, removed)
+}
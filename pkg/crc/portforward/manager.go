@@ -0,0 +1,187 @@
+package portforward
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/oc"
+	crcssh "github.com/code-ready/crc/pkg/crc/ssh"
+)
+
+// DialFunc opens a connection to the target of a forward
+type DialFunc func(forward Forward) (net.Conn, error)
+
+// Manager listens on the localhost ports of the forwards and relays the
+// connections to their targets
+type Manager struct {
+	dial DialFunc
+
+	lock      sync.Mutex
+	listeners map[int]*forwardListener
+}
+
+type forwardListener struct {
+	forward  Forward
+	listener net.Listener
+}
+
+func NewManager(dial DialFunc) *Manager {
+	return &Manager{
+		dial:      dial,
+		listeners: make(map[int]*forwardListener),
+	}
+}
+
+func (m *Manager) Add(forward Forward) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if existing, ok := m.listeners[forward.HostPort]; ok {
+		return fmt.Errorf("host port %d is already forwarded to %s", forward.HostPort, existing.forward.Target())
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(forward.HostPort)))
+	if err != nil {
+		return err
+	}
+	m.listeners[forward.HostPort] = &forwardListener{
+		forward:  forward,
+		listener: listener,
+	}
+	go m.serve(forward, listener)
+	return nil
+}
+
+func (m *Manager) Remove(hostPort int) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	existing, ok := m.listeners[hostPort]
+	if !ok {
+		return fmt.Errorf("host port %d is not forwarded", hostPort)
+	}
+	delete(m.listeners, hostPort)
+	return existing.listener.Close()
+}
+
+// List returns the forwards sorted by host port
+func (m *Manager) List() []Forward {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	forwards := []Forward{}
+	for _, existing := range m.listeners {
+		forwards = append(forwards, existing.forward)
+	}
+	sort.Slice(forwards, func(i, j int) bool {
+		return forwards[i].HostPort < forwards[j].HostPort
+	})
+	return forwards
+}
+
+func (m *Manager) serve(forward Forward, listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			// the listener is closed when the forward is removed
+			return
+		}
+		go m.relay(forward, conn)
+	}
+}
+
+func (m *Manager) relay(forward Forward, conn net.Conn) {
+	defer conn.Close()
+	remote, err := m.dial(forward)
+	if err != nil {
+		logging.Warnf("Cannot forward connection from port %d to %s: %v", forward.HostPort, forward.Target(), err)
+		return
+	}
+	defer remote.Close()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(remote, conn)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(conn, remote)
+		done <- struct{}{}
+	}()
+	// closing both connections stops the other copy
+	<-done
+}
+
+// ServiceDialer connects to the ports of the services of the cluster. The
+// connections are tunnelled through SSH as the cluster IPs are only reachable
+// from the VM. The cluster IPs are cached as looking them up runs oc in the VM.
+type ServiceDialer struct {
+	lock       sync.Mutex
+	clusterIPs map[string]string
+}
+
+func NewServiceDialer() *ServiceDialer {
+	return &ServiceDialer{
+		clusterIPs: make(map[string]string),
+	}
+}
+
+func (d *ServiceDialer) Dial(sshRunner *crcssh.Runner, forward Forward) (net.Conn, error) {
+	clusterIP, cached := d.cachedClusterIP(forward)
+	if cached {
+		conn, err := dialClusterIP(sshRunner, clusterIP, forward)
+		if err == nil {
+			return conn, nil
+		}
+		// the service may have been recreated with another cluster IP
+		d.forget(forward)
+	}
+	clusterIP, err := serviceClusterIP(sshRunner, forward)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := dialClusterIP(sshRunner, clusterIP, forward)
+	if err != nil {
+		return nil, err
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.clusterIPs[serviceKey(forward)] = clusterIP
+	return conn, nil
+}
+
+func (d *ServiceDialer) cachedClusterIP(forward Forward) (string, bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	clusterIP, ok := d.clusterIPs[serviceKey(forward)]
+	return clusterIP, ok
+}
+
+func (d *ServiceDialer) forget(forward Forward) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	delete(d.clusterIPs, serviceKey(forward))
+}
+
+func serviceKey(forward Forward) string {
+	return forward.Namespace + "/" + forward.Service
+}
+
+func serviceClusterIP(sshRunner *crcssh.Runner, forward Forward) (string, error) {
+	stdout, stderr, err := oc.UseOCWithSSH(sshRunner).RunOcCommand("get", "service", "--namespace", forward.Namespace, forward.Service,
+		"--output", "jsonpath={.spec.clusterIP}")
+	if err != nil {
+		return "", fmt.Errorf("Cannot find service %s/%s: %w: %s", forward.Namespace, forward.Service, err, strings.TrimSpace(stderr))
+	}
+	clusterIP := strings.TrimSpace(stdout)
+	if clusterIP == "" || clusterIP == "None" {
+		return "", fmt.Errorf("Service %s/%s has no cluster IP", forward.Namespace, forward.Service)
+	}
+	return clusterIP, nil
+}
+
+func dialClusterIP(sshRunner *crcssh.Runner, clusterIP string, forward Forward) (net.Conn, error) {
+	return sshRunner.Dial("tcp", net.JoinHostPort(clusterIP, strconv.Itoa(forward.ServicePort)))
+}
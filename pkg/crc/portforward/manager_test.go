@@ -0,0 +1,65 @@
+package portforward
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func freePort(t *testing.T) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func TestManager(t *testing.T) {
+	// the target echoes the lines it receives
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer target.Close()
+	go func() {
+		for {
+			conn, err := target.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				scanner := bufio.NewScanner(conn)
+				for scanner.Scan() {
+					fmt.Fprintf(conn, "%s\n", scanner.Text())
+				}
+			}()
+		}
+	}()
+
+	dialed := make(chan Forward, 1)
+	manager := NewManager(func(forward Forward) (net.Conn, error) {
+		dialed <- forward
+		return net.Dial("tcp", target.Addr().String())
+	})
+	forward := Forward{HostPort: freePort(t), VMPort: 5432}
+	require.NoError(t, manager.Add(forward))
+	assert.EqualError(t, manager.Add(forward), fmt.Sprintf("host port %d is already forwarded to 5432", forward.HostPort))
+	assert.Equal(t, []Forward{forward}, manager.List())
+
+	conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", forward.HostPort))
+	require.NoError(t, err)
+	fmt.Fprintln(conn, "hello")
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	assert.NoError(t, err)
+	assert.Equal(t, "hello\n", line)
+	conn.Close()
+	assert.Equal(t, forward, <-dialed)
+
+	require.NoError(t, manager.Remove(forward.HostPort))
+	assert.Empty(t, manager.List())
+	assert.EqualError(t, manager.Remove(forward.HostPort), fmt.Sprintf("host port %d is not forwarded", forward.HostPort))
+	_, err = net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", forward.HostPort))
+	assert.Error(t, err)
+}
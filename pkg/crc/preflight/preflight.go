@@ -6,6 +6,7 @@ import (
 	crcConfig "github.com/code-ready/crc/pkg/crc/config"
 	"github.com/code-ready/crc/pkg/crc/errors"
 	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/network"
 )
 
 type Flags uint32
@@ -155,29 +156,68 @@ func StartPreflightChecks(config crcConfig.Storage) error {
 	experimentalFeatures := config.Get(crcConfig.ExperimentalFeatures).AsBool()
 	mode := crcConfig.GetNetworkMode(config)
 	trayAutostart := config.Get(crcConfig.AutostartTray).AsBool()
-	if err := doPreflightChecks(config, getPreflightChecks(experimentalFeatures, trayAutostart, mode)); err != nil {
+	domains := crcConfig.GetDomains(config)
+	if err := doPreflightChecks(config, getPreflightChecks(experimentalFeatures, trayAutostart, mode, domains)); err != nil {
 		return &errors.PreflightError{Err: err}
 	}
 	return nil
 }
 
+// CheckResult is the outcome of a single preflight check
+type CheckResult struct {
+	Description string `json:"description"`
+	Skipped     bool   `json:"skipped,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// CheckHost runs all the preflight checks of 'crc start', without stopping
+// at the first failure, and returns their results
+func CheckHost(config crcConfig.Storage) []CheckResult {
+	experimentalFeatures := config.Get(crcConfig.ExperimentalFeatures).AsBool()
+	mode := crcConfig.GetNetworkMode(config)
+	trayAutostart := config.Get(crcConfig.AutostartTray).AsBool()
+	domains := crcConfig.GetDomains(config)
+	return doCheckHost(config, getPreflightChecks(experimentalFeatures, trayAutostart, mode, domains))
+}
+
+func doCheckHost(config crcConfig.Storage, checks []Check) []CheckResult {
+	var results []CheckResult
+	for _, check := range checks {
+		if check.flags&SetupOnly == SetupOnly || check.flags&CleanUpOnly == CleanUpOnly {
+			continue
+		}
+		result := CheckResult{
+			Description: check.checkDescription,
+			Skipped:     check.shouldSkip(config),
+		}
+		if !result.Skipped {
+			if err := check.check(); err != nil {
+				result.Error = err.Error()
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
 // SetupHost performs the prerequisite checks and setups the host to run the cluster
 func SetupHost(config crcConfig.Storage, checkOnly bool) error {
 	experimentalFeatures := config.Get(crcConfig.ExperimentalFeatures).AsBool()
 	mode := crcConfig.GetNetworkMode(config)
 	trayAutostart := config.Get(crcConfig.AutostartTray).AsBool()
-	return doFixPreflightChecks(config, getPreflightChecks(experimentalFeatures, trayAutostart, mode), checkOnly)
+	domains := crcConfig.GetDomains(config)
+	return doFixPreflightChecks(config, getPreflightChecks(experimentalFeatures, trayAutostart, mode, domains), checkOnly)
 }
 
 func RegisterSettings(config crcConfig.Schema) {
-	doRegisterSettings(config, getAllPreflightChecks())
+	doRegisterSettings(config, getAllPreflightChecks(network.DefaultDomains))
 }
 
-func CleanUpHost() error {
+func CleanUpHost(config crcConfig.Storage) error {
 	// A user can use setup with experiment flag
 	// and not use cleanup with same flag, to avoid
 	// any extra step/confusion we are just adding the checks
 	// which are behind the experiment flag. This way cleanup
 	// perform action in a sane way.
-	return doCleanUpPreflightChecks(getAllPreflightChecks())
+	return doCleanUpPreflightChecks(getAllPreflightChecks(crcConfig.GetDomains(config)))
 }
@@ -2,6 +2,7 @@ package preflight
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -12,6 +13,7 @@ import (
 	"github.com/code-ready/crc/pkg/crc/constants"
 	"github.com/code-ready/crc/pkg/crc/logging"
 	"github.com/code-ready/crc/pkg/crc/machine/bundle"
+	"github.com/code-ready/crc/pkg/crc/network"
 	"github.com/code-ready/crc/pkg/crc/validation"
 	"github.com/code-ready/crc/pkg/crc/version"
 	"github.com/code-ready/crc/pkg/embed"
@@ -92,12 +94,14 @@ var genericPreflightChecks = []Check{
 	},
 }
 
-var cleanUpHostsFile = Check{
-	cleanupDescription: "Removing hosts file records added by CRC",
-	cleanup:            removeHostsFileEntry,
-	flags:              CleanUpOnly,
+func cleanUpHostsFile(domains network.Domains) Check {
+	return Check{
+		cleanupDescription: "Removing hosts file records added by CRC",
+		cleanup:            removeHostsFileEntry(domains),
+		flags:              CleanUpOnly,
 
-	labels: None,
+		labels: None,
+	}
 }
 
 func checkSupportedCPUArch() error {
@@ -221,6 +225,22 @@ func fixOldAdminHelperExecutableCached() error {
 	return nil
 }
 
+// instanceNames returns the names of the instances which have a directory in
+// the machines directory, the default instance is always part of them
+func instanceNames() []string {
+	names := []string{constants.DefaultName}
+	entries, err := ioutil.ReadDir(constants.MachineInstanceDir)
+	if err != nil {
+		return names
+	}
+	for _, entry := range entries {
+		if entry.IsDir() && entry.Name() != constants.DefaultName {
+			names = append(names, entry.Name())
+		}
+	}
+	return names
+}
+
 func removeCRCMachinesDir() error {
 	logging.Debug("Deleting machines directory")
 	if err := os.RemoveAll(constants.MachineInstanceDir); err != nil {
@@ -243,10 +263,12 @@ func removeOldLogs() error {
 	return nil
 }
 
-func removeHostsFileEntry() error {
-	err := adminhelper.CleanHostsFile()
-	if errors.Is(err, os.ErrNotExist) {
-		return nil
+func removeHostsFileEntry(domains network.Domains) CleanUpFunc {
+	return func() error {
+		err := adminhelper.CleanHostsFile(domains.Custom()...)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
 	}
-	return err
 }
@@ -151,38 +151,67 @@ func fixQcowToolInstalled() error {
 	return nil
 }
 
-func checkResolverFilePermissions() error {
-	return isUserHaveFileWritePermission(resolverFile)
+// resolverFiles returns the resolver file of the domain of the bundles and the
+// ones of the custom domains set in the config it does not cover
+func resolverFiles(domains network.Domains) []string {
+	files := []string{resolverFile}
+	for _, domain := range domains.Custom() {
+		if !strings.HasSuffix(domain, "."+filepath.Base(resolverFile)) {
+			files = append(files, filepath.Join(resolverDir, domain))
+		}
+	}
+	return files
 }
 
-func fixResolverFilePermissions() error {
-	// Check if resolver directory available or not
-	if _, err := os.Stat(resolverDir); os.IsNotExist(err) {
-		logging.Debugf("Creating %s directory", resolverDir)
-		stdOut, stdErr, err := crcos.RunPrivileged(fmt.Sprintf("Creating dir %s", resolverDir), "mkdir", resolverDir)
-		if err != nil {
-			return fmt.Errorf("Unable to create the resolver Dir: %s %v: %s", stdOut, err, stdErr)
+func checkResolverFilePermissions(domains network.Domains) CheckFunc {
+	return func() error {
+		for _, file := range resolverFiles(domains) {
+			if err := isUserHaveFileWritePermission(file); err != nil {
+				return err
+			}
 		}
+		return nil
 	}
-	logging.Debugf("Making %s readable/writable by the current user", resolverFile)
-	stdOut, stdErr, err := crcos.RunPrivileged(fmt.Sprintf("Creating file %s", resolverFile), "touch", resolverFile)
-	if err != nil {
-		return fmt.Errorf("Unable to create the resolver file: %s %v: %s", stdOut, err, stdErr)
-	}
+}
 
-	return addFileWritePermissionToUser(resolverFile)
+func fixResolverFilePermissions(domains network.Domains) FixFunc {
+	return func() error {
+		// Check if resolver directory available or not
+		if _, err := os.Stat(resolverDir); os.IsNotExist(err) {
+			logging.Debugf("Creating %s directory", resolverDir)
+			stdOut, stdErr, err := crcos.RunPrivileged(fmt.Sprintf("Creating dir %s", resolverDir), "mkdir", resolverDir)
+			if err != nil {
+				return fmt.Errorf("Unable to create the resolver Dir: %s %v: %s", stdOut, err, stdErr)
+			}
+		}
+		for _, file := range resolverFiles(domains) {
+			logging.Debugf("Making %s readable/writable by the current user", file)
+			stdOut, stdErr, err := crcos.RunPrivileged(fmt.Sprintf("Creating file %s", file), "touch", file)
+			if err != nil {
+				return fmt.Errorf("Unable to create the resolver file: %s %v: %s", stdOut, err, stdErr)
+			}
+			if err := addFileWritePermissionToUser(file); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 }
 
-func removeResolverFile() error {
-	// Check if the resolver file exist or not
-	if _, err := os.Stat(resolverFile); !os.IsNotExist(err) {
-		logging.Debugf("Removing %s file", resolverFile)
-		err := crcos.RemoveFileAsRoot(fmt.Sprintf("Removing file %s", resolverFile), resolverFile)
-		if err != nil {
-			return fmt.Errorf("Unable to delete the resolver File: %s %v", resolverFile, err)
+func removeResolverFile(domains network.Domains) CleanUpFunc {
+	return func() error {
+		for _, file := range resolverFiles(domains) {
+			// Check if the resolver file exist or not
+			if _, err := os.Stat(file); !os.IsNotExist(err) {
+				logging.Debugf("Removing %s file", file)
+				err := crcos.RemoveFileAsRoot(fmt.Sprintf("Removing file %s", file), file)
+				if err != nil {
+					return fmt.Errorf("Unable to delete the resolver File: %s %v", file, err)
+				}
+			}
 		}
+		return nil
 	}
-	return nil
 }
 
 func isUserHaveFileWritePermission(filename string) error {
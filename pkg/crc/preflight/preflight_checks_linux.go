@@ -14,7 +14,7 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/code-ready/crc/pkg/crc/cache"
-	"github.com/code-ready/crc/pkg/crc/constants"
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
 	"github.com/code-ready/crc/pkg/crc/logging"
 	"github.com/code-ready/crc/pkg/crc/machine/libvirt"
 	"github.com/code-ready/crc/pkg/crc/systemd"
@@ -413,25 +413,38 @@ func removeLibvirtCrcNetwork() error {
 }
 
 func removeCrcVM() error {
-	stdout, _, err := crcos.RunWithDefaultLocale("virsh", "--connect", "qemu:///system", "domstate", constants.DefaultName)
+	var mErr crcErrors.MultiError
+	for _, name := range instanceNames() {
+		if err := removeVM(name); err != nil {
+			mErr.Collect(err)
+		}
+	}
+	if len(mErr.Errors) == 0 {
+		return nil
+	}
+	return mErr
+}
+
+func removeVM(name string) error {
+	stdout, _, err := crcos.RunWithDefaultLocale("virsh", "--connect", "qemu:///system", "domstate", name)
 	if err != nil {
 		//  User may have run `crc delete` before `crc cleanup`
 		//  in that case there is no crc vm so return early.
 		return nil
 	}
 	if strings.TrimSpace(stdout) == "running" {
-		_, stderr, err := crcos.RunWithDefaultLocale("virsh", "--connect", "qemu:///system", "destroy", constants.DefaultName)
+		_, stderr, err := crcos.RunWithDefaultLocale("virsh", "--connect", "qemu:///system", "destroy", name)
 		if err != nil {
 			logging.Debugf("%v : %s", err, stderr)
-			return fmt.Errorf("Failed to destroy 'crc' VM")
+			return fmt.Errorf("Failed to destroy '%s' VM", name)
 		}
 	}
-	_, stderr, err := crcos.RunWithDefaultLocale("virsh", "--connect", "qemu:///system", "undefine", constants.DefaultName)
+	_, stderr, err := crcos.RunWithDefaultLocale("virsh", "--connect", "qemu:///system", "undefine", name)
 	if err != nil {
 		logging.Debugf("%v : %s", err, stderr)
-		return fmt.Errorf("Failed to undefine 'crc' VM")
+		return fmt.Errorf("Failed to undefine '%s' VM", name)
 	}
-	logging.Debug("'crc' VM is removed")
+	logging.Debugf("'%s' VM is removed", name)
 	return nil
 }
 
@@ -7,8 +7,11 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 
+	"github.com/code-ready/crc/pkg/crc/constants"
 	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/network"
 	"github.com/code-ready/crc/pkg/crc/systemd"
 	"github.com/code-ready/crc/pkg/crc/systemd/states"
 	crcos "github.com/code-ready/crc/pkg/os"
@@ -44,47 +47,46 @@ var nmPreflightChecks = []Check{
 	},
 }
 
-var dnsmasqPreflightChecks = []Check{
-	{
-		configKeySuffix:    "check-network-manager-config",
-		checkDescription:   "Checking if /etc/NetworkManager/conf.d/crc-nm-dnsmasq.conf exists",
-		check:              checkCrcNetworkManagerConfig,
-		fixDescription:     "Writing Network Manager config for crc",
-		fix:                fixCrcNetworkManagerConfig,
-		cleanupDescription: "Removing /etc/NetworkManager/conf.d/crc-nm-dnsmasq.conf file",
-		cleanup:            removeCrcNetworkManagerConfig,
-
-		labels: labels{Os: Linux, NetworkMode: System, DNS: Dnsmasq},
-	},
-	{
-		configKeySuffix:    "check-crc-dnsmasq-file",
-		checkDescription:   "Checking if /etc/NetworkManager/dnsmasq.d/crc.conf exists",
-		check:              checkCrcDnsmasqConfigFile,
-		fixDescription:     "Writing dnsmasq config for crc",
-		fix:                fixCrcDnsmasqConfigFile,
-		cleanupDescription: "Removing /etc/NetworkManager/dnsmasq.d/crc.conf file",
-		cleanup:            removeCrcDnsmasqConfigFile,
-
-		labels: labels{Os: Linux, NetworkMode: System, DNS: Dnsmasq},
-	},
+func dnsmasqPreflightChecks(domains network.Domains) []Check {
+	return []Check{
+		{
+			configKeySuffix:    "check-network-manager-config",
+			checkDescription:   "Checking if /etc/NetworkManager/conf.d/crc-nm-dnsmasq.conf exists",
+			check:              checkCrcNetworkManagerConfig,
+			fixDescription:     "Writing Network Manager config for crc",
+			fix:                fixCrcNetworkManagerConfig,
+			cleanupDescription: "Removing /etc/NetworkManager/conf.d/crc-nm-dnsmasq.conf file",
+			cleanup:            removeCrcNetworkManagerConfig,
+
+			labels: labels{Os: Linux, NetworkMode: System, DNS: Dnsmasq},
+		},
+		{
+			configKeySuffix:    "check-crc-dnsmasq-file",
+			checkDescription:   "Checking if /etc/NetworkManager/dnsmasq.d/crc.conf exists",
+			check:              checkCrcDnsmasqConfigFile(domains),
+			fixDescription:     "Writing dnsmasq config for crc",
+			fix:                fixCrcDnsmasqConfigFile(domains),
+			cleanupDescription: "Removing /etc/NetworkManager/dnsmasq.d/crc.conf file",
+			cleanup:            removeCrcDnsmasqConfigFile,
+
+			labels: labels{Os: Linux, NetworkMode: System, DNS: Dnsmasq},
+		},
+	}
 }
 
 var (
 	crcNetworkManagerRootPath = filepath.Join(string(filepath.Separator), "etc", "NetworkManager")
 
 	crcDnsmasqConfigPath = filepath.Join(crcNetworkManagerRootPath, "dnsmasq.d", "crc.conf")
-	crcDnsmasqConfig     = `server=/apps-crc.testing/192.168.130.11
-server=/crc.testing/192.168.130.11
-`
 
 	crcNetworkManagerConfigPath = filepath.Join(crcNetworkManagerRootPath, "conf.d", "crc-nm-dnsmasq.conf")
 	crcNetworkManagerConfig     = `[main]
 dns=dnsmasq
 `
 
-	crcNetworkManagerOldDispatcherPath = filepath.Join(crcNetworkManagerRootPath, "dispatcher.d", "pre-up.d", "99-crc.sh")
-	crcNetworkManagerDispatcherPath    = filepath.Join(crcNetworkManagerRootPath, "dispatcher.d", "99-crc.sh")
-	crcNetworkManagerDispatcherConfig  = `#!/bin/sh
+	crcNetworkManagerOldDispatcherPath  = filepath.Join(crcNetworkManagerRootPath, "dispatcher.d", "pre-up.d", "99-crc.sh")
+	crcNetworkManagerDispatcherPath     = filepath.Join(crcNetworkManagerRootPath, "dispatcher.d", "99-crc.sh")
+	crcNetworkManagerDispatcherTemplate = `#!/bin/sh
 # This is a NetworkManager dispatcher script to configure split DNS for
 # the 'crc' libvirt network.
 #
@@ -104,42 +106,62 @@ dns=dnsmasq
 
 export LC_ALL=C
 
-systemd-resolve --interface crc --set-dns 192.168.130.11 --set-domain ~testing
+systemd-resolve --interface crc --set-dns 192.168.130.11 --set-domain ~testing%s
 
 exit 0
 `
 )
 
-var systemdResolvedPreflightChecks = []Check{
-	{
-		configKeySuffix:  "check-dnsmasq-network-manager-config",
-		checkDescription: "Checking if dnsmasq configurations file exist for NetworkManager",
-		check:            checkCrcDnsmasqAndNetworkManagerConfigFile,
-		fixDescription:   "Removing dnsmasq configuration file for NetworkManager",
-		fix:              fixCrcDnsmasqAndNetworkManagerConfigFile,
+// crcDnsmasqConfig forwards the queries for the domains of the bundles, and
+// for the custom domains set in the config, to the DNS server of the VM
+func crcDnsmasqConfig(domains network.Domains) string {
+	var config strings.Builder
+	for _, domain := range append([]string{constants.DefaultAppsDomain, constants.DefaultClusterDomain}, domains.Custom()...) {
+		fmt.Fprintf(&config, "server=/%s/192.168.130.11\n", domain)
+	}
+	return config.String()
+}
 
-		labels: labels{Os: Linux, NetworkMode: System, DNS: SystemdResolved},
-	},
-	{
-		configKeySuffix:  "check-systemd-resolved-running",
-		checkDescription: "Checking if the systemd-resolved service is running",
-		check:            checkSystemdResolvedIsRunning,
-		fixDescription:   "systemd-resolved is required on this distribution. Please make sure it is installed and running manually",
-		flags:            NoFix,
+func crcNetworkManagerDispatcherConfig(domains network.Domains) string {
+	var customDomains string
+	for _, domain := range domains.Custom() {
+		customDomains += fmt.Sprintf(" --set-domain ~%s", domain)
+	}
+	return fmt.Sprintf(crcNetworkManagerDispatcherTemplate, customDomains)
+}
 
-		labels: labels{Os: Linux, NetworkMode: System, DNS: SystemdResolved},
-	},
-	{
-		configKeySuffix:    "check-network-manager-dispatcher-file",
-		checkDescription:   fmt.Sprintf("Checking if %s exists", crcNetworkManagerDispatcherPath),
-		check:              checkCrcNetworkManagerDispatcherFile,
-		fixDescription:     "Writing NetworkManager dispatcher file for crc",
-		fix:                fixCrcNetworkManagerDispatcherFile,
-		cleanupDescription: fmt.Sprintf("Removing %s file", crcNetworkManagerDispatcherPath),
-		cleanup:            removeCrcNetworkManagerDispatcherFile,
-
-		labels: labels{Os: Linux, NetworkMode: System, DNS: SystemdResolved},
-	},
+func systemdResolvedPreflightChecks(domains network.Domains) []Check {
+	return []Check{
+		{
+			configKeySuffix:  "check-dnsmasq-network-manager-config",
+			checkDescription: "Checking if dnsmasq configurations file exist for NetworkManager",
+			check:            checkCrcDnsmasqAndNetworkManagerConfigFile,
+			fixDescription:   "Removing dnsmasq configuration file for NetworkManager",
+			fix:              fixCrcDnsmasqAndNetworkManagerConfigFile,
+
+			labels: labels{Os: Linux, NetworkMode: System, DNS: SystemdResolved},
+		},
+		{
+			configKeySuffix:  "check-systemd-resolved-running",
+			checkDescription: "Checking if the systemd-resolved service is running",
+			check:            checkSystemdResolvedIsRunning,
+			fixDescription:   "systemd-resolved is required on this distribution. Please make sure it is installed and running manually",
+			flags:            NoFix,
+
+			labels: labels{Os: Linux, NetworkMode: System, DNS: SystemdResolved},
+		},
+		{
+			configKeySuffix:    "check-network-manager-dispatcher-file",
+			checkDescription:   fmt.Sprintf("Checking if %s exists", crcNetworkManagerDispatcherPath),
+			check:              checkCrcNetworkManagerDispatcherFile(domains),
+			fixDescription:     "Writing NetworkManager dispatcher file for crc",
+			fix:                fixCrcNetworkManagerDispatcherFile(domains),
+			cleanupDescription: fmt.Sprintf("Removing %s file", crcNetworkManagerDispatcherPath),
+			cleanup:            removeCrcNetworkManagerDispatcherFile,
+
+			labels: labels{Os: Linux, NetworkMode: System, DNS: SystemdResolved},
+		},
+	}
 }
 
 func fixNetworkManagerConfigFile(path string, content string, perms os.FileMode) error {
@@ -186,25 +208,29 @@ func removeNetworkManagerConfigFile(path string) error {
 	return nil
 }
 
-func checkCrcDnsmasqConfigFile() error {
-	logging.Debug("Checking dnsmasq configuration")
-	err := crcos.FileContentMatches(crcDnsmasqConfigPath, []byte(crcDnsmasqConfig))
-	if err != nil {
-		return err
+func checkCrcDnsmasqConfigFile(domains network.Domains) CheckFunc {
+	return func() error {
+		logging.Debug("Checking dnsmasq configuration")
+		err := crcos.FileContentMatches(crcDnsmasqConfigPath, []byte(crcDnsmasqConfig(domains)))
+		if err != nil {
+			return err
+		}
+		logging.Debug("dnsmasq configuration is good")
+		return nil
 	}
-	logging.Debug("dnsmasq configuration is good")
-	return nil
 }
 
-func fixCrcDnsmasqConfigFile() error {
-	logging.Debug("Fixing dnsmasq configuration")
-	err := fixNetworkManagerConfigFile(crcDnsmasqConfigPath, crcDnsmasqConfig, 0644)
-	if err != nil {
-		return err
-	}
+func fixCrcDnsmasqConfigFile(domains network.Domains) FixFunc {
+	return func() error {
+		logging.Debug("Fixing dnsmasq configuration")
+		err := fixNetworkManagerConfigFile(crcDnsmasqConfigPath, crcDnsmasqConfig(domains), 0644)
+		if err != nil {
+			return err
+		}
 
-	logging.Debug("dnsmasq configuration fixed")
-	return nil
+		logging.Debug("dnsmasq configuration fixed")
+		return nil
+	}
 }
 
 func removeCrcDnsmasqConfigFile() error {
@@ -277,29 +303,33 @@ func checkSystemdResolvedIsRunning() error {
 	return checkSystemdServiceRunning("systemd-resolved.service")
 }
 
-func checkCrcNetworkManagerDispatcherFile() error {
-	logging.Debug("Checking NetworkManager dispatcher file for crc network")
-	err := crcos.FileContentMatches(crcNetworkManagerDispatcherPath, []byte(crcNetworkManagerDispatcherConfig))
-	if err != nil {
-		return err
+func checkCrcNetworkManagerDispatcherFile(domains network.Domains) CheckFunc {
+	return func() error {
+		logging.Debug("Checking NetworkManager dispatcher file for crc network")
+		err := crcos.FileContentMatches(crcNetworkManagerDispatcherPath, []byte(crcNetworkManagerDispatcherConfig(domains)))
+		if err != nil {
+			return err
+		}
+		logging.Debug("Dispatcher file has the expected content")
+		return nil
 	}
-	logging.Debug("Dispatcher file has the expected content")
-	return nil
 }
 
-func fixCrcNetworkManagerDispatcherFile() error {
-	logging.Debug("Fixing NetworkManager dispatcher configuration")
+func fixCrcNetworkManagerDispatcherFile(domains network.Domains) FixFunc {
+	return func() error {
+		logging.Debug("Fixing NetworkManager dispatcher configuration")
 
-	// Remove dispatcher script which was used in crc 1.20 - it's been moved to a new location
-	_ = removeNetworkManagerConfigFile(crcNetworkManagerOldDispatcherPath)
+		// Remove dispatcher script which was used in crc 1.20 - it's been moved to a new location
+		_ = removeNetworkManagerConfigFile(crcNetworkManagerOldDispatcherPath)
 
-	err := fixNetworkManagerConfigFile(crcNetworkManagerDispatcherPath, crcNetworkManagerDispatcherConfig, 0755)
-	if err != nil {
-		return err
-	}
+		err := fixNetworkManagerConfigFile(crcNetworkManagerDispatcherPath, crcNetworkManagerDispatcherConfig(domains), 0755)
+		if err != nil {
+			return err
+		}
 
-	logging.Debug("NetworkManager dispatcher configuration fixed")
-	return nil
+		logging.Debug("NetworkManager dispatcher configuration fixed")
+		return nil
+	}
 }
 
 func removeCrcNetworkManagerDispatcherFile() error {
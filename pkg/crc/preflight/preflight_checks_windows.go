@@ -10,7 +10,7 @@ import (
 	winnet "github.com/code-ready/crc/pkg/os/windows/network"
 	"github.com/code-ready/crc/pkg/os/windows/powershell"
 
-	"github.com/code-ready/crc/pkg/crc/constants"
+	crcErrors "github.com/code-ready/crc/pkg/crc/errors"
 	"github.com/code-ready/crc/pkg/crc/machine/hyperv"
 )
 
@@ -192,22 +192,35 @@ func removeDNSServerAddress() error {
 	return nil
 }
 
-func removeCrcVM() (err error) {
-	if _, _, err := powershell.Execute("Get-VM -Name crc"); err != nil {
+func removeCrcVM() error {
+	var mErr crcErrors.MultiError
+	for _, name := range instanceNames() {
+		if err := removeVM(name); err != nil {
+			mErr.Collect(err)
+		}
+	}
+	if len(mErr.Errors) == 0 {
+		return nil
+	}
+	return mErr
+}
+
+func removeVM(name string) error {
+	if _, _, err := powershell.Execute(fmt.Sprintf(`Get-VM -Name "%s"`, name)); err != nil {
 		// This means that there is no crc VM exist
 		return nil
 	}
-	stopVMCommand := fmt.Sprintf(`Stop-VM -Name "%s" -Force`, constants.DefaultName)
+	stopVMCommand := fmt.Sprintf(`Stop-VM -Name "%s" -Force`, name)
 	if _, _, err := powershell.Execute(stopVMCommand); err != nil {
 		// ignore the error as this is useless (prefer not to use nolint here)
 		return err
 	}
-	removeVMCommand := fmt.Sprintf(`Remove-VM -Name "%s" -Force`, constants.DefaultName)
+	removeVMCommand := fmt.Sprintf(`Remove-VM -Name "%s" -Force`, name)
 	if _, _, err := powershell.Execute(removeVMCommand); err != nil {
 		// ignore the error as this is useless (prefer not to use nolint here)
 		return err
 	}
-	logging.Debug("'crc' VM is removed")
+	logging.Debugf("'%s' VM is removed", name)
 	return nil
 }
 
@@ -56,18 +56,20 @@ func hyperkitPreflightChecks(networkMode network.Mode) []Check {
 	}
 }
 
-var resolverPreflightChecks = []Check{
-	{
-		configKeySuffix:    "check-resolver-file-permissions",
-		checkDescription:   fmt.Sprintf("Checking file permissions for %s", resolverFile),
-		check:              checkResolverFilePermissions,
-		fixDescription:     fmt.Sprintf("Setting file permissions for %s", resolverFile),
-		fix:                fixResolverFilePermissions,
-		cleanupDescription: fmt.Sprintf("Removing %s file", resolverFile),
-		cleanup:            removeResolverFile,
-
-		labels: labels{Os: Darwin, NetworkMode: System},
-	},
+func resolverPreflightChecks(domains network.Domains) []Check {
+	return []Check{
+		{
+			configKeySuffix:    "check-resolver-file-permissions",
+			checkDescription:   fmt.Sprintf("Checking file permissions for %s", resolverFile),
+			check:              checkResolverFilePermissions(domains),
+			fixDescription:     fmt.Sprintf("Setting file permissions for %s", resolverFile),
+			fix:                fixResolverFilePermissions(domains),
+			cleanupDescription: fmt.Sprintf("Removing %s file", resolverFile),
+			cleanup:            removeResolverFile(domains),
+
+			labels: labels{Os: Darwin, NetworkMode: System},
+		},
+	}
 }
 
 var daemonSetupChecks = []Check{
@@ -134,29 +136,29 @@ func (filter preflightFilter) SetTray(enable bool) {
 //
 // Passing 'SystemNetworkingMode' to getPreflightChecks currently achieves this
 // as there are no user networking specific checks
-func getAllPreflightChecks() []Check {
-	return getPreflightChecks(true, true, network.SystemNetworkingMode)
+func getAllPreflightChecks(domains network.Domains) []Check {
+	return getPreflightChecks(true, true, network.SystemNetworkingMode, domains)
 }
 
-func getChecks(mode network.Mode) []Check {
+func getChecks(mode network.Mode, domains network.Domains) []Check {
 	checks := []Check{}
 
 	checks = append(checks, nonWinPreflightChecks...)
 	checks = append(checks, genericPreflightChecks...)
-	checks = append(checks, cleanUpHostsFile)
+	checks = append(checks, cleanUpHostsFile(domains))
 	checks = append(checks, hyperkitPreflightChecks(mode)...)
 	checks = append(checks, daemonSetupChecks...)
-	checks = append(checks, resolverPreflightChecks...)
+	checks = append(checks, resolverPreflightChecks(domains)...)
 	checks = append(checks, traySetupChecks...)
 	checks = append(checks, bundleCheck)
 
 	return checks
 }
 
-func getPreflightChecks(_ bool, trayAutostart bool, mode network.Mode) []Check {
+func getPreflightChecks(_ bool, trayAutostart bool, mode network.Mode, domains network.Domains) []Check {
 	filter := newFilter()
 	filter.SetNetworkMode(mode)
 	filter.SetTray(trayAutostart)
 
-	return filter.Apply(getChecks(mode))
+	return filter.Apply(getChecks(mode, domains))
 }
@@ -283,41 +283,41 @@ func (filter preflightFilter) SetDistro(distro *linux.OsRelease) {
 // - matching the current distro
 // - matching the networking daemon in use (NetworkManager or systemd-resolved) regardless of user/system networking
 // - and we also want the user networking checks
-func getAllPreflightChecks() []Check {
+func getAllPreflightChecks(domains network.Domains) []Check {
 	usingSystemdResolved := checkSystemdResolvedIsRunning()
 	filter := newFilter()
 	filter.SetSystemdResolved(usingSystemdResolved == nil)
 	filter.SetDistro(distro())
 
-	return filter.Apply(getChecks(distro()))
+	return filter.Apply(getChecks(distro(), domains))
 }
 
-func getPreflightChecks(_ bool, _ bool, networkMode network.Mode) []Check {
+func getPreflightChecks(_ bool, _ bool, networkMode network.Mode, domains network.Domains) []Check {
 	usingSystemdResolved := checkSystemdResolvedIsRunning()
 
-	return getPreflightChecksForDistro(distro(), networkMode, usingSystemdResolved == nil)
+	return getPreflightChecksForDistro(distro(), networkMode, usingSystemdResolved == nil, domains)
 }
 
-func getPreflightChecksForDistro(distro *linux.OsRelease, networkMode network.Mode, usingSystemdResolved bool) []Check {
+func getPreflightChecksForDistro(distro *linux.OsRelease, networkMode network.Mode, usingSystemdResolved bool, domains network.Domains) []Check {
 	filter := newFilter()
 	filter.SetDistro(distro)
 	filter.SetNetworkMode(networkMode)
 	filter.SetSystemdResolved(usingSystemdResolved)
 
-	return filter.Apply(getChecks(distro))
+	return filter.Apply(getChecks(distro, domains))
 }
 
-func getChecks(distro *linux.OsRelease) []Check {
+func getChecks(distro *linux.OsRelease, domains network.Domains) []Check {
 	var checks []Check
 	checks = append(checks, nonWinPreflightChecks...)
 	checks = append(checks, wsl2PreflightCheck)
 	checks = append(checks, genericPreflightChecks...)
-	checks = append(checks, cleanUpHostsFile)
+	checks = append(checks, cleanUpHostsFile(domains))
 	checks = append(checks, libvirtPreflightChecks(distro)...)
 	checks = append(checks, ubuntuPreflightChecks...)
 	checks = append(checks, nmPreflightChecks...)
-	checks = append(checks, systemdResolvedPreflightChecks...)
-	checks = append(checks, dnsmasqPreflightChecks...)
+	checks = append(checks, systemdResolvedPreflightChecks(domains)...)
+	checks = append(checks, dnsmasqPreflightChecks(domains)...)
 	checks = append(checks, libvirtNetworkPreflightChecks...)
 	checks = append(checks, vsockPreflightCheck)
 	checks = append(checks, bundleCheck)
@@ -18,7 +18,7 @@ func TestCountConfigurationOptions(t *testing.T) {
 	options := len(cfg.AllConfigs())
 
 	var preflightChecksCount int
-	for _, check := range getAllPreflightChecks() {
+	for _, check := range getAllPreflightChecks(network.DefaultDomains) {
 		if check.configKeySuffix != "" {
 			preflightChecksCount++
 		}
@@ -71,7 +71,7 @@ var checkListForDistros = []checkListForDistro{
 			{cleanup: removeCRCMachinesDir},
 			{cleanup: removeOldLogs},
 			{cleanup: cluster.ForgetPullSecret},
-			{cleanup: removeHostsFileEntry},
+			{cleanup: removeHostsFileEntry(network.DefaultDomains)},
 			{check: checkVirtualizationEnabled},
 			{check: checkKvmEnabled},
 			{check: checkLibvirtInstalled},
@@ -86,7 +86,7 @@ var checkListForDistros = []checkListForDistro{
 			{check: checkNetworkManagerIsRunning},
 			{check: checkCrcDnsmasqAndNetworkManagerConfigFile},
 			{check: checkSystemdResolvedIsRunning},
-			{check: checkCrcNetworkManagerDispatcherFile},
+			{check: checkCrcNetworkManagerDispatcherFile(network.DefaultDomains)},
 			{check: checkLibvirtCrcNetworkAvailable},
 			{check: checkLibvirtCrcNetworkActive},
 			{check: checkBundleExtracted},
@@ -106,7 +106,7 @@ var checkListForDistros = []checkListForDistro{
 			{cleanup: removeCRCMachinesDir},
 			{cleanup: removeOldLogs},
 			{cleanup: cluster.ForgetPullSecret},
-			{cleanup: removeHostsFileEntry},
+			{cleanup: removeHostsFileEntry(network.DefaultDomains)},
 			{check: checkVirtualizationEnabled},
 			{check: checkKvmEnabled},
 			{check: checkLibvirtInstalled},
@@ -120,7 +120,7 @@ var checkListForDistros = []checkListForDistro{
 			{check: checkNetworkManagerInstalled},
 			{check: checkNetworkManagerIsRunning},
 			{check: checkCrcNetworkManagerConfig},
-			{check: checkCrcDnsmasqConfigFile},
+			{check: checkCrcDnsmasqConfigFile(network.DefaultDomains)},
 			{check: checkLibvirtCrcNetworkAvailable},
 			{check: checkLibvirtCrcNetworkActive},
 			{check: checkBundleExtracted},
@@ -140,7 +140,7 @@ var checkListForDistros = []checkListForDistro{
 			{cleanup: removeCRCMachinesDir},
 			{cleanup: removeOldLogs},
 			{cleanup: cluster.ForgetPullSecret},
-			{cleanup: removeHostsFileEntry},
+			{cleanup: removeHostsFileEntry(network.DefaultDomains)},
 			{check: checkVirtualizationEnabled},
 			{check: checkKvmEnabled},
 			{check: checkLibvirtInstalled},
@@ -168,7 +168,7 @@ var checkListForDistros = []checkListForDistro{
 			{cleanup: removeCRCMachinesDir},
 			{cleanup: removeOldLogs},
 			{cleanup: cluster.ForgetPullSecret},
-			{cleanup: removeHostsFileEntry},
+			{cleanup: removeHostsFileEntry(network.DefaultDomains)},
 			{check: checkVirtualizationEnabled},
 			{check: checkKvmEnabled},
 			{check: checkLibvirtInstalled},
@@ -183,7 +183,7 @@ var checkListForDistros = []checkListForDistro{
 			{check: checkNetworkManagerIsRunning},
 			{check: checkCrcDnsmasqAndNetworkManagerConfigFile},
 			{check: checkSystemdResolvedIsRunning},
-			{check: checkCrcNetworkManagerDispatcherFile},
+			{check: checkCrcNetworkManagerDispatcherFile(network.DefaultDomains)},
 			{check: checkLibvirtCrcNetworkAvailable},
 			{check: checkLibvirtCrcNetworkActive},
 			{check: checkBundleExtracted},
@@ -203,7 +203,7 @@ var checkListForDistros = []checkListForDistro{
 			{cleanup: removeCRCMachinesDir},
 			{cleanup: removeOldLogs},
 			{cleanup: cluster.ForgetPullSecret},
-			{cleanup: removeHostsFileEntry},
+			{cleanup: removeHostsFileEntry(network.DefaultDomains)},
 			{check: checkVirtualizationEnabled},
 			{check: checkKvmEnabled},
 			{check: checkLibvirtInstalled},
@@ -217,7 +217,7 @@ var checkListForDistros = []checkListForDistro{
 			{check: checkNetworkManagerInstalled},
 			{check: checkNetworkManagerIsRunning},
 			{check: checkCrcNetworkManagerConfig},
-			{check: checkCrcDnsmasqConfigFile},
+			{check: checkCrcDnsmasqConfigFile(network.DefaultDomains)},
 			{check: checkLibvirtCrcNetworkAvailable},
 			{check: checkLibvirtCrcNetworkActive},
 			{check: checkBundleExtracted},
@@ -237,7 +237,7 @@ var checkListForDistros = []checkListForDistro{
 			{cleanup: removeCRCMachinesDir},
 			{cleanup: removeOldLogs},
 			{cleanup: cluster.ForgetPullSecret},
-			{cleanup: removeHostsFileEntry},
+			{cleanup: removeHostsFileEntry(network.DefaultDomains)},
 			{check: checkVirtualizationEnabled},
 			{check: checkKvmEnabled},
 			{check: checkLibvirtInstalled},
@@ -265,7 +265,7 @@ var checkListForDistros = []checkListForDistro{
 			{cleanup: removeCRCMachinesDir},
 			{cleanup: removeOldLogs},
 			{cleanup: cluster.ForgetPullSecret},
-			{cleanup: removeHostsFileEntry},
+			{cleanup: removeHostsFileEntry(network.DefaultDomains)},
 			{check: checkVirtualizationEnabled},
 			{check: checkKvmEnabled},
 			{check: checkLibvirtInstalled},
@@ -280,7 +280,7 @@ var checkListForDistros = []checkListForDistro{
 			{check: checkNetworkManagerIsRunning},
 			{check: checkCrcDnsmasqAndNetworkManagerConfigFile},
 			{check: checkSystemdResolvedIsRunning},
-			{check: checkCrcNetworkManagerDispatcherFile},
+			{check: checkCrcNetworkManagerDispatcherFile(network.DefaultDomains)},
 			{check: checkLibvirtCrcNetworkAvailable},
 			{check: checkLibvirtCrcNetworkActive},
 			{check: checkBundleExtracted},
@@ -300,7 +300,7 @@ var checkListForDistros = []checkListForDistro{
 			{cleanup: removeCRCMachinesDir},
 			{cleanup: removeOldLogs},
 			{cleanup: cluster.ForgetPullSecret},
-			{cleanup: removeHostsFileEntry},
+			{cleanup: removeHostsFileEntry(network.DefaultDomains)},
 			{check: checkVirtualizationEnabled},
 			{check: checkKvmEnabled},
 			{check: checkLibvirtInstalled},
@@ -314,7 +314,7 @@ var checkListForDistros = []checkListForDistro{
 			{check: checkNetworkManagerInstalled},
 			{check: checkNetworkManagerIsRunning},
 			{check: checkCrcNetworkManagerConfig},
-			{check: checkCrcDnsmasqConfigFile},
+			{check: checkCrcDnsmasqConfigFile(network.DefaultDomains)},
 			{check: checkLibvirtCrcNetworkAvailable},
 			{check: checkLibvirtCrcNetworkActive},
 			{check: checkBundleExtracted},
@@ -334,7 +334,7 @@ var checkListForDistros = []checkListForDistro{
 			{cleanup: removeCRCMachinesDir},
 			{cleanup: removeOldLogs},
 			{cleanup: cluster.ForgetPullSecret},
-			{cleanup: removeHostsFileEntry},
+			{cleanup: removeHostsFileEntry(network.DefaultDomains)},
 			{check: checkVirtualizationEnabled},
 			{check: checkKvmEnabled},
 			{check: checkLibvirtInstalled},
@@ -362,7 +362,7 @@ var checkListForDistros = []checkListForDistro{
 			{cleanup: removeCRCMachinesDir},
 			{cleanup: removeOldLogs},
 			{cleanup: cluster.ForgetPullSecret},
-			{cleanup: removeHostsFileEntry},
+			{cleanup: removeHostsFileEntry(network.DefaultDomains)},
 			{check: checkVirtualizationEnabled},
 			{check: checkKvmEnabled},
 			{check: checkLibvirtInstalled},
@@ -378,7 +378,7 @@ var checkListForDistros = []checkListForDistro{
 			{check: checkNetworkManagerIsRunning},
 			{check: checkCrcDnsmasqAndNetworkManagerConfigFile},
 			{check: checkSystemdResolvedIsRunning},
-			{check: checkCrcNetworkManagerDispatcherFile},
+			{check: checkCrcNetworkManagerDispatcherFile(network.DefaultDomains)},
 			{check: checkLibvirtCrcNetworkAvailable},
 			{check: checkLibvirtCrcNetworkActive},
 			{check: checkBundleExtracted},
@@ -398,7 +398,7 @@ var checkListForDistros = []checkListForDistro{
 			{cleanup: removeCRCMachinesDir},
 			{cleanup: removeOldLogs},
 			{cleanup: cluster.ForgetPullSecret},
-			{cleanup: removeHostsFileEntry},
+			{cleanup: removeHostsFileEntry(network.DefaultDomains)},
 			{check: checkVirtualizationEnabled},
 			{check: checkKvmEnabled},
 			{check: checkLibvirtInstalled},
@@ -413,7 +413,7 @@ var checkListForDistros = []checkListForDistro{
 			{check: checkNetworkManagerInstalled},
 			{check: checkNetworkManagerIsRunning},
 			{check: checkCrcNetworkManagerConfig},
-			{check: checkCrcDnsmasqConfigFile},
+			{check: checkCrcDnsmasqConfigFile(network.DefaultDomains)},
 			{check: checkLibvirtCrcNetworkAvailable},
 			{check: checkLibvirtCrcNetworkActive},
 			{check: checkBundleExtracted},
@@ -433,7 +433,7 @@ var checkListForDistros = []checkListForDistro{
 			{cleanup: removeCRCMachinesDir},
 			{cleanup: removeOldLogs},
 			{cleanup: cluster.ForgetPullSecret},
-			{cleanup: removeHostsFileEntry},
+			{cleanup: removeHostsFileEntry(network.DefaultDomains)},
 			{check: checkVirtualizationEnabled},
 			{check: checkKvmEnabled},
 			{check: checkLibvirtInstalled},
@@ -459,7 +459,7 @@ func assertFuncEqual(t *testing.T, func1 interface{}, func2 interface{}) {
 }
 
 func assertExpectedPreflights(t *testing.T, distro *crcos.OsRelease, networkMode network.Mode, systemdResolved bool) {
-	preflights := getPreflightChecksForDistro(distro, networkMode, systemdResolved)
+	preflights := getPreflightChecksForDistro(distro, networkMode, systemdResolved, network.DefaultDomains)
 	var expected checkListForDistro
 	for _, expected = range checkListForDistros {
 		if expected.distro == distro && expected.networkMode == networkMode && expected.systemdResolved == systemdResolved {
@@ -503,3 +503,13 @@ func TestCountPreflights(t *testing.T) {
 	assertExpectedPreflights(t, &ubuntu, network.SystemNetworkingMode, false)
 	assertExpectedPreflights(t, &ubuntu, network.UserNetworkingMode, false)
 }
+
+func TestDNSConfigWithCustomDomains(t *testing.T) {
+	assert.Equal(t, "server=/apps-crc.testing/192.168.130.11\nserver=/crc.testing/192.168.130.11\n", crcDnsmasqConfig(network.DefaultDomains))
+	assert.Contains(t, crcNetworkManagerDispatcherConfig(network.DefaultDomains), "--set-domain ~testing\n")
+
+	domains := network.Domains{Cluster: "crc.example.lan", Apps: "apps.example.lan"}
+	assert.Equal(t, "server=/apps-crc.testing/192.168.130.11\nserver=/crc.testing/192.168.130.11\n"+
+		"server=/apps.example.lan/192.168.130.11\nserver=/crc.example.lan/192.168.130.11\n", crcDnsmasqConfig(domains))
+	assert.Contains(t, crcNetworkManagerDispatcherConfig(domains), "--set-domain ~testing --set-domain ~apps.example.lan --set-domain ~crc.example.lan\n")
+}
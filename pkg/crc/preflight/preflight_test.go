@@ -49,6 +49,22 @@ func TestFixPreflightCheckOnly(t *testing.T) {
 	assert.False(t, calls.fixed)
 }
 
+func TestCheckHost(t *testing.T) {
+	failing, _ := sampleCheck(errors.New("check failed"), nil)
+	passing, calls := sampleCheck(nil, nil)
+	passing.configKeySuffix = "other"
+	cfg := config.New(config.NewEmptyInMemoryStorage())
+	doRegisterSettings(cfg, []Check{*failing, *passing})
+	_, err := cfg.Set("skip-other", true)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []CheckResult{
+		{Description: "Sample check", Error: "check failed"},
+		{Description: "Sample check", Skipped: true},
+	}, doCheckHost(cfg, []Check{*failing, *passing}))
+	assert.False(t, calls.checked)
+}
+
 func sampleCheck(checkErr, fixErr error) (*Check, *status) {
 	status := &status{}
 	return &Check{
@@ -246,7 +246,6 @@ var adminHelperChecks = []Check{
 		},
 		labels: labels{Os: Windows},
 	},
-	cleanUpHostsFile,
 }
 
 const (
@@ -302,25 +301,26 @@ func (filter preflightFilter) SetTray(enable bool) {
 //
 // Passing 'UserNetworkingMode' to getPreflightChecks currently achieves this
 // as there are no system networking specific checks
-func getAllPreflightChecks() []Check {
-	return getPreflightChecks(true, true, network.UserNetworkingMode)
+func getAllPreflightChecks(domains network.Domains) []Check {
+	return getPreflightChecks(true, true, network.UserNetworkingMode, domains)
 }
 
-func getChecks() []Check {
+func getChecks(domains network.Domains) []Check {
 	checks := []Check{}
 	checks = append(checks, genericPreflightChecks...)
 	checks = append(checks, hypervPreflightChecks...)
 	checks = append(checks, adminHelperChecks...)
+	checks = append(checks, cleanUpHostsFile(domains))
 	checks = append(checks, vsockChecks...)
 	checks = append(checks, traySetupChecks...)
 	checks = append(checks, bundleCheck)
 	return checks
 }
 
-func getPreflightChecks(_ bool, trayAutoStart bool, networkMode network.Mode) []Check {
+func getPreflightChecks(_ bool, trayAutoStart bool, networkMode network.Mode, domains network.Domains) []Check {
 	filter := newFilter()
 	filter.SetNetworkMode(networkMode)
 	filter.SetTray(trayAutoStart)
 
-	return filter.Apply(getChecks())
+	return filter.Apply(getChecks(domains))
 }
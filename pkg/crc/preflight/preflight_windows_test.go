@@ -15,9 +15,9 @@ func TestCountConfigurationOptions(t *testing.T) {
 }
 
 func TestCountPreflights(t *testing.T) {
-	assert.Len(t, getPreflightChecks(false, false, network.SystemNetworkingMode), 20)
-	assert.Len(t, getPreflightChecks(true, true, network.SystemNetworkingMode), 20)
+	assert.Len(t, getPreflightChecks(false, false, network.SystemNetworkingMode, network.DefaultDomains), 20)
+	assert.Len(t, getPreflightChecks(true, true, network.SystemNetworkingMode, network.DefaultDomains), 20)
 
-	assert.Len(t, getPreflightChecks(false, false, network.UserNetworkingMode), 21)
-	assert.Len(t, getPreflightChecks(true, true, network.UserNetworkingMode), 21)
+	assert.Len(t, getPreflightChecks(false, false, network.UserNetworkingMode, network.DefaultDomains), 21)
+	assert.Len(t, getPreflightChecks(true, true, network.UserNetworkingMode, network.DefaultDomains), 21)
 }
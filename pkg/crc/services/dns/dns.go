@@ -2,11 +2,13 @@ package dns
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/code-ready/crc/pkg/crc/adminhelper"
-	"github.com/code-ready/crc/pkg/crc/constants"
 	"github.com/code-ready/crc/pkg/crc/errors"
+	"github.com/code-ready/crc/pkg/crc/logging"
+	"github.com/code-ready/crc/pkg/crc/machine/bundle"
 	"github.com/code-ready/crc/pkg/crc/network"
 	"github.com/code-ready/crc/pkg/crc/services"
 )
@@ -17,34 +19,93 @@ const (
 	dnsContainerIP              = "10.88.0.8"
 	dnsContainerImage           = "quay.io/crcont/dnsmasq:latest"
 	publicDNSQueryURI           = "quay.io"
+	// maxNameServers is the number of nameservers of resolv.conf used by glibc
+	maxNameServers = 3
 )
 
 func init() {
 }
 
 func RunPostStart(serviceConfig services.ServicePostStartConfig) error {
-	if err := setupDnsmasq(serviceConfig); err != nil {
+	orgNameServers, err := originalNameServers(serviceConfig)
+	if err != nil {
 		return err
 	}
 
-	if err := runPostStartForOS(serviceConfig); err != nil {
+	if err := setupDnsmasq(serviceConfig, upstreamServers(serviceConfig, orgNameServers)); err != nil {
 		return err
 	}
 
-	resolvFileValues, err := getResolvFileValues(serviceConfig)
-	if err != nil {
+	if err := runPostStartForOS(serviceConfig); err != nil {
 		return err
 	}
+
+	resolvFileValues := getResolvFileValues(serviceConfig, orgNameServers)
 	// override resolv.conf file
 	return network.CreateResolvFileOnInstance(serviceConfig.SSHRunner, resolvFileValues)
 }
 
-func setupDnsmasq(serviceConfig services.ServicePostStartConfig) error {
+// useDnsmasq returns false in user network mode, the DNS server of the daemon
+// serves the cluster domains and the user defined records
+func useDnsmasq(serviceConfig services.ServicePostStartConfig) bool {
+	return serviceConfig.NetworkMode != network.UserNetworkingMode
+}
+
+// originalNameServers returns the nameservers the instance got from the
+// network, the ones written by a previous start are skipped
+func originalNameServers(serviceConfig services.ServicePostStartConfig) ([]network.NameServer, error) {
 	if serviceConfig.NetworkMode == network.UserNetworkingMode {
+		return nil, nil
+	}
+	orgResolvValues, err := network.GetResolvValuesFromInstance(serviceConfig.SSHRunner)
+	if err != nil {
+		return nil, err
+	}
+	var nameservers []network.NameServer
+	for _, ns := range orgResolvValues.NameServers {
+		if ns.IPAddress == dnsContainerIP || containsNameServer(serviceConfig.NameServers, ns) {
+			continue
+		}
+		nameservers = append(nameservers, ns)
+	}
+	return nameservers, nil
+}
+
+// upstreamServers returns the servers dnsmasq forwards the queries to, an
+// empty list lets dnsmasq use the resolv.conf of the container
+func upstreamServers(serviceConfig services.ServicePostStartConfig, orgNameServers []network.NameServer) []string {
+	if len(serviceConfig.UpstreamDNS) > 0 {
+		return serviceConfig.UpstreamDNS
+	}
+	if len(serviceConfig.NameServers) > 0 {
+		var servers []string
+		for _, nameservers := range [][]network.NameServer{serviceConfig.NameServers, orgNameServers} {
+			for _, ns := range nameservers {
+				servers = append(servers, ns.IPAddress)
+			}
+		}
+		return servers
+	}
+	return nil
+}
+
+func containsNameServer(nameservers []network.NameServer, nameserver network.NameServer) bool {
+	for _, ns := range nameservers {
+		if ns.IPAddress == nameserver.IPAddress {
+			return true
+		}
+	}
+	return false
+}
+
+func setupDnsmasq(serviceConfig services.ServicePostStartConfig, upstreamServers []string) error {
+	if !useDnsmasq(serviceConfig) {
+		// the dnsmasq container may remain from a previous start
+		_, _, _ = serviceConfig.SSHRunner.Run("sudo podman rm -f dnsmasq")
 		return nil
 	}
 
-	if err := createDnsmasqDNSConfig(serviceConfig); err != nil {
+	if err := createDnsmasqDNSConfig(serviceConfig, upstreamServers); err != nil {
 		return err
 	}
 
@@ -65,34 +126,43 @@ func setupDnsmasq(serviceConfig services.ServicePostStartConfig) error {
 	return nil
 }
 
-func getResolvFileValues(serviceConfig services.ServicePostStartConfig) (network.ResolvFileValues, error) {
-	dnsServers, err := dnsServers(serviceConfig)
-	if err != nil {
-		return network.ResolvFileValues{}, err
+func getResolvFileValues(serviceConfig services.ServicePostStartConfig, orgNameServers []network.NameServer) network.ResolvFileValues {
+	searchDomains := []network.SearchDomain{
+		{
+			Domain: fmt.Sprintf("%s.%s", serviceConfig.Name, serviceConfig.BundleMetadata.ClusterInfo.BaseDomain),
+		},
+	}
+	for _, domain := range serviceConfig.SearchDomains {
+		searchDomains = append(searchDomains, network.SearchDomain{Domain: domain})
 	}
 	return network.ResolvFileValues{
-		SearchDomains: []network.SearchDomain{
-			{
-				Domain: fmt.Sprintf("%s.%s", serviceConfig.Name, serviceConfig.BundleMetadata.ClusterInfo.BaseDomain),
-			},
-		},
-		NameServers: dnsServers,
-	}, nil
+		SearchDomains: searchDomains,
+		NameServers:   dnsServers(serviceConfig, orgNameServers),
+	}
 }
 
-func dnsServers(serviceConfig services.ServicePostStartConfig) ([]network.NameServer, error) {
-	if serviceConfig.NetworkMode == network.UserNetworkingMode {
-		return []network.NameServer{
-			{
-				IPAddress: constants.VSockGateway,
-			},
-		}, nil
+func dnsServers(serviceConfig services.ServicePostStartConfig, orgNameServers []network.NameServer) []network.NameServer {
+	ip := dnsContainerIP
+	if !useDnsmasq(serviceConfig) {
+		ip = serviceConfig.VSockNetwork.Gateway().String()
 	}
-	orgResolvValues, err := network.GetResolvValuesFromInstance(serviceConfig.SSHRunner)
-	if err != nil {
-		return nil, err
+	nameservers := []network.NameServer{{IPAddress: ip}}
+	for _, list := range [][]network.NameServer{serviceConfig.NameServers, orgNameServers} {
+		for _, ns := range list {
+			if !containsNameServer(nameservers, ns) {
+				nameservers = append(nameservers, ns)
+			}
+		}
 	}
-	return append([]network.NameServer{{IPAddress: dnsContainerIP}}, orgResolvValues.NameServers...), nil
+	if len(nameservers) > maxNameServers {
+		var ignored []string
+		for _, ns := range nameservers[maxNameServers:] {
+			ignored = append(ignored, ns.IPAddress)
+		}
+		logging.Warnf("Only %d nameservers can be used in the VM, ignoring %s", maxNameServers, strings.Join(ignored, ", "))
+		nameservers = nameservers[:maxNameServers]
+	}
+	return nameservers
 }
 
 func CheckCRCLocalDNSReachable(serviceConfig services.ServicePostStartConfig) (string, error) {
@@ -121,10 +191,41 @@ func CheckCRCPublicDNSReachable(serviceConfig services.ServicePostStartConfig) (
 }
 
 func addOpenShiftHosts(serviceConfig services.ServicePostStartConfig) error {
-	return adminhelper.UpdateHostsFile(serviceConfig.IP, serviceConfig.BundleMetadata.GetAPIHostname(),
-		serviceConfig.BundleMetadata.GetAppHostname("oauth-openshift"),
-		serviceConfig.BundleMetadata.GetAppHostname("console-openshift-console"),
-		serviceConfig.BundleMetadata.GetAppHostname("downloads-openshift-console"),
-		serviceConfig.BundleMetadata.GetAppHostname("canary-openshift-ingress-canary"),
-		serviceConfig.BundleMetadata.GetAppHostname("default-route-openshift-image-registry"))
+	hostnames, customHostnames := hostsFileHostnames(&serviceConfig.BundleMetadata)
+	if len(hostnames) > 0 {
+		if err := adminhelper.UpdateHostsFile(serviceConfig.IP, hostnames...); err != nil {
+			return err
+		}
+	}
+	// in system network mode, the DNS configuration of the host resolves the
+	// custom domains
+	if len(customHostnames) == 0 || serviceConfig.NetworkMode != network.UserNetworkingMode {
+		return nil
+	}
+	if err := adminhelper.AddCustomHostsToHostsFile(serviceConfig.IP, customHostnames...); err != nil {
+		logging.Warnf("Cannot add %s to the hosts file, they are not resolved on the host: %v", strings.Join(customHostnames, ", "), err)
+	}
+	return nil
+}
+
+// hostsFileHostnames returns the hostnames of the cluster which are added to
+// the hosts file. The admin helper only accepts the domains of the bundles, the
+// names of the custom domains are returned separately.
+func hostsFileHostnames(bundleMetadata *bundle.CrcBundleInfo) ([]string, []string) {
+	var hostnames, customHostnames []string
+	for _, hostname := range []string{
+		bundleMetadata.GetAPIHostname(),
+		bundleMetadata.GetAppHostname("oauth-openshift"),
+		bundleMetadata.GetAppHostname("console-openshift-console"),
+		bundleMetadata.GetAppHostname("downloads-openshift-console"),
+		bundleMetadata.GetAppHostname("canary-openshift-ingress-canary"),
+		bundleMetadata.GetAppHostname("default-route-openshift-image-registry"),
+	} {
+		if adminhelper.IsSupportedHostname(hostname) {
+			hostnames = append(hostnames, hostname)
+		} else {
+			customHostnames = append(customHostnames, hostname)
+		}
+	}
+	return hostnames, customHostnames
 }
@@ -46,6 +46,13 @@ func runPostStartForOS(serviceConfig services.ServicePostStartConfig) error {
 	if err != nil {
 		return err
 	}
+	for _, domain := range customResolverDomains(serviceConfig) {
+		changed, err := createResolverFile(serviceConfig.IP, domain, domain)
+		if err != nil {
+			return err
+		}
+		needRestart = needRestart || changed
+	}
 	if needRestart {
 		// Restart the Network on mac
 		logging.Infof("Restarting the host network")
@@ -65,6 +72,19 @@ func runPostStartForOS(serviceConfig services.ServicePostStartConfig) error {
 	return nil
 }
 
+// customResolverDomains returns the cluster and apps domains set in the config
+// which are not covered by the resolver file of the base domain
+func customResolverDomains(serviceConfig services.ServicePostStartConfig) []string {
+	baseDomain := serviceConfig.BundleMetadata.ClusterInfo.BaseDomain
+	var domains []string
+	for _, domain := range []string{serviceConfig.BundleMetadata.GetClusterDomain(), serviceConfig.BundleMetadata.GetAppsDomain()} {
+		if !strings.HasSuffix(domain, "."+baseDomain) {
+			domains = append(domains, domain)
+		}
+	}
+	return domains
+}
+
 func createResolverFile(instanceIP string, domain string, filename string) (bool, error) {
 	var resolverFile bytes.Buffer
 
@@ -0,0 +1,104 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/code-ready/crc/pkg/crc/machine/bundle"
+	"github.com/code-ready/crc/pkg/crc/network"
+	"github.com/code-ready/crc/pkg/crc/services"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	orgNameServers  = []network.NameServer{{IPAddress: "192.168.130.1"}}
+	userNameServers = []network.NameServer{{IPAddress: "10.10.0.53"}, {IPAddress: "fd00::53"}}
+)
+
+func TestUpstreamServers(t *testing.T) {
+	serviceConfig := services.ServicePostStartConfig{
+		NetworkMode: network.SystemNetworkingMode,
+	}
+	assert.Nil(t, upstreamServers(serviceConfig, orgNameServers))
+
+	serviceConfig.NameServers = userNameServers
+	assert.Equal(t, []string{"10.10.0.53", "fd00::53", "192.168.130.1"}, upstreamServers(serviceConfig, orgNameServers))
+
+	serviceConfig.UpstreamDNS = []string{"10.10.1.53"}
+	assert.Equal(t, []string{"10.10.1.53"}, upstreamServers(serviceConfig, orgNameServers))
+}
+
+func TestDNSServers(t *testing.T) {
+	serviceConfig := services.ServicePostStartConfig{
+		NetworkMode: network.SystemNetworkingMode,
+		NameServers: userNameServers,
+	}
+	assert.Equal(t, []network.NameServer{
+		{IPAddress: dnsContainerIP},
+		{IPAddress: "10.10.0.53"},
+		{IPAddress: "fd00::53"},
+	}, dnsServers(serviceConfig, orgNameServers))
+
+	serviceConfig.NameServers = userNameServers[:1]
+	assert.Equal(t, []network.NameServer{
+		{IPAddress: dnsContainerIP},
+		{IPAddress: "10.10.0.53"},
+		{IPAddress: "192.168.130.1"},
+	}, dnsServers(serviceConfig, orgNameServers))
+}
+
+func TestDNSServersInUserNetworkMode(t *testing.T) {
+	serviceConfig := services.ServicePostStartConfig{
+		NetworkMode:  network.UserNetworkingMode,
+		VSockNetwork: network.DefaultVSockNetwork,
+	}
+	assert.Equal(t, []network.NameServer{{IPAddress: "192.168.127.1"}}, dnsServers(serviceConfig, nil))
+
+	serviceConfig.NameServers = userNameServers[:1]
+	assert.Equal(t, []network.NameServer{{IPAddress: "192.168.127.1"}, {IPAddress: "10.10.0.53"}}, dnsServers(serviceConfig, nil))
+}
+
+func testBundle() *bundle.CrcBundleInfo {
+	return &bundle.CrcBundleInfo{
+		ClusterInfo: bundle.ClusterInfo{
+			ClusterName: "crc",
+			BaseDomain:  "testing",
+			AppsDomain:  "apps-crc.testing",
+		},
+	}
+}
+
+func TestHostsFileHostnames(t *testing.T) {
+	bundleMetadata := testBundle()
+	hostnames, customHostnames := hostsFileHostnames(bundleMetadata)
+	assert.Equal(t, []string{
+		"api.crc.testing",
+		"oauth-openshift.apps-crc.testing",
+		"console-openshift-console.apps-crc.testing",
+		"downloads-openshift-console.apps-crc.testing",
+		"canary-openshift-ingress-canary.apps-crc.testing",
+		"default-route-openshift-image-registry.apps-crc.testing",
+	}, hostnames)
+	assert.Empty(t, customHostnames)
+
+	bundleMetadata.UseDomains("crc.testing", "apps.example.com")
+	hostnames, customHostnames = hostsFileHostnames(bundleMetadata)
+	assert.Equal(t, []string{"api.crc.testing"}, hostnames)
+	assert.Equal(t, []string{
+		"oauth-openshift.apps.example.com",
+		"console-openshift-console.apps.example.com",
+		"downloads-openshift-console.apps.example.com",
+		"canary-openshift-ingress-canary.apps.example.com",
+		"default-route-openshift-image-registry.apps.example.com",
+	}, customHostnames)
+}
+
+func TestAddOpenShiftHostsWithCustomDomains(t *testing.T) {
+	bundleMetadata := testBundle()
+	bundleMetadata.UseDomains("crc.example.com", "apps.example.com")
+	// the admin helper, which rejects these domains, is not called, the DNS
+	// configuration of the host resolves them in system network mode
+	assert.NoError(t, addOpenShiftHosts(services.ServicePostStartConfig{
+		IP:             "192.168.130.11",
+		BundleMetadata: *bundleMetadata,
+	}))
+}
@@ -2,8 +2,10 @@ package dns
 
 import (
 	"bytes"
+	"fmt"
 	"text/template"
 
+	"github.com/code-ready/crc/pkg/crc/network"
 	"github.com/code-ready/crc/pkg/crc/services"
 )
 
@@ -19,6 +21,23 @@ address=/{{ .AppsDomain }}/{{ .IP }}
 address=/api.{{ .ClusterName}}.{{ .BaseDomain }}/{{ .IP }}
 address=/api-int.{{ .ClusterName}}.{{ .BaseDomain }}/{{ .IP }}
 address=/{{ .Hostname }}.{{ .ClusterName}}.{{ .BaseDomain }}/{{ .InternalIP }}
+{{- if .CustomAppsDomain }}
+address=/{{ .CustomAppsDomain }}/{{ .IP }}
+{{- end }}
+{{- if .CustomClusterDomain }}
+address=/api.{{ .CustomClusterDomain }}/{{ .IP }}
+address=/api-int.{{ .CustomClusterDomain }}/{{ .IP }}
+address=/{{ .Hostname }}.{{ .CustomClusterDomain }}/{{ .InternalIP }}
+{{- end }}
+{{- range .Records }}
+host-record={{ .Name }},{{ .IP }}
+{{- end }}
+{{- if .UpstreamServers }}
+no-resolv
+{{- range .UpstreamServers }}
+server={{ . }}
+{{- end }}
+{{- end }}
 `
 )
 
@@ -30,9 +49,26 @@ type dnsmasqConfFileValues struct {
 	IP          string
 	AppsDomain  string
 	InternalIP  string
+
+	// the cluster keeps using the domains of the bundle, the ones set in the
+	// config are only added for the host
+	CustomClusterDomain string
+	CustomAppsDomain    string
+
+	Records         []network.DNSRecord
+	UpstreamServers []string
+}
+
+func createDnsmasqDNSConfig(serviceConfig services.ServicePostStartConfig, upstreamServers []string) error {
+	dnsConfig, err := createDNSConfigFile(getDnsmasqConfFileValues(serviceConfig, upstreamServers), dnsmasqConfTemplate)
+	if err != nil {
+		return err
+	}
+
+	return serviceConfig.SSHRunner.CopyData([]byte(dnsConfig), dnsConfigFilePathInInstance, 0644)
 }
 
-func createDnsmasqDNSConfig(serviceConfig services.ServicePostStartConfig) error {
+func getDnsmasqConfFileValues(serviceConfig services.ServicePostStartConfig, upstreamServers []string) dnsmasqConfFileValues {
 	domain := serviceConfig.BundleMetadata.ClusterInfo.BaseDomain
 
 	dnsmasqConfFileValues := dnsmasqConfFileValues{
@@ -44,13 +80,16 @@ func createDnsmasqDNSConfig(serviceConfig services.ServicePostStartConfig) error
 		IP:          serviceConfig.IP,
 		InternalIP:  serviceConfig.BundleMetadata.Nodes[0].InternalIP,
 	}
-
-	dnsConfig, err := createDNSConfigFile(dnsmasqConfFileValues, dnsmasqConfTemplate)
-	if err != nil {
-		return err
+	if clusterDomain := serviceConfig.BundleMetadata.GetClusterDomain(); clusterDomain != fmt.Sprintf("%s.%s", dnsmasqConfFileValues.ClusterName, domain) {
+		dnsmasqConfFileValues.CustomClusterDomain = clusterDomain
+	}
+	if appsDomain := serviceConfig.BundleMetadata.GetAppsDomain(); appsDomain != dnsmasqConfFileValues.AppsDomain {
+		dnsmasqConfFileValues.CustomAppsDomain = appsDomain
 	}
+	dnsmasqConfFileValues.Records = serviceConfig.DNSRecords
+	dnsmasqConfFileValues.UpstreamServers = upstreamServers
 
-	return serviceConfig.SSHRunner.CopyData([]byte(dnsConfig), "/var/srv/dnsmasq.conf", 0644)
+	return dnsmasqConfFileValues
 }
 
 func createDNSConfigFile(values dnsmasqConfFileValues, tmpl string) (string, error) {
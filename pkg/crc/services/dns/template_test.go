@@ -0,0 +1,63 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/code-ready/crc/pkg/crc/network"
+	"github.com/stretchr/testify/assert"
+)
+
+var dnsmasqValues = dnsmasqConfFileValues{
+	BaseDomain:  "testing",
+	Port:        53,
+	ClusterName: "crc",
+	Hostname:    "crc-h66l2-master-0",
+	IP:          "192.168.130.11",
+	AppsDomain:  "apps-crc.testing",
+	InternalIP:  "192.168.126.11",
+}
+
+const dnsmasqConf = `user=root
+port= 53
+bind-interfaces
+expand-hosts
+log-queries
+local=/crc.testing/
+domain=crc.testing
+address=/apps-crc.testing/192.168.130.11
+address=/api.crc.testing/192.168.130.11
+address=/api-int.crc.testing/192.168.130.11
+address=/crc-h66l2-master-0.crc.testing/192.168.126.11
+`
+
+func TestDnsmasqConfig(t *testing.T) {
+	config, err := createDNSConfigFile(dnsmasqValues, dnsmasqConfTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, dnsmasqConf, config)
+}
+
+func TestDnsmasqConfigWithCustomDomains(t *testing.T) {
+	values := dnsmasqValues
+	values.CustomClusterDomain = "crc.example.lan"
+	values.CustomAppsDomain = "apps.example.lan"
+	config, err := createDNSConfigFile(values, dnsmasqConfTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, dnsmasqConf+`address=/apps.example.lan/192.168.130.11
+address=/api.crc.example.lan/192.168.130.11
+address=/api-int.crc.example.lan/192.168.130.11
+address=/crc-h66l2-master-0.crc.example.lan/192.168.126.11
+`, config)
+}
+
+func TestDnsmasqConfigWithRecordsAndUpstreamServers(t *testing.T) {
+	values := dnsmasqValues
+	values.Records = []network.DNSRecord{{Name: "registry.staging.corp", IP: "10.10.2.5"}}
+	values.UpstreamServers = []string{"10.10.0.53", "fd00::53"}
+	config, err := createDNSConfigFile(values, dnsmasqConfTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, dnsmasqConf+`host-record=registry.staging.corp,10.10.2.5
+no-resolv
+server=10.10.0.53
+server=fd00::53
+`, config)
+}
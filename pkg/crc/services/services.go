@@ -12,4 +12,9 @@ type ServicePostStartConfig struct {
 	BundleMetadata bundle.CrcBundleInfo
 	IP             string
 	NetworkMode    network.Mode
+	VSockNetwork   network.VSockNetwork
+	DNSRecords     []network.DNSRecord
+	UpstreamDNS    []string
+	NameServers    []network.NameServer
+	SearchDomains  []string
 }
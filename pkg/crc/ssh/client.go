@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"strconv"
@@ -15,6 +16,9 @@ import (
 
 type Client interface {
 	Run(command string) ([]byte, []byte, error)
+	Dial(network, address string) (net.Conn, error)
+	Stream(command string, stdin io.Reader, stdout, stderr io.Writer) error
+	Shell(term string, width, height int, stdin io.Reader, stdout, stderr io.Writer) error
 	Close()
 }
 
@@ -71,17 +75,21 @@ func clientConfig(user string, keys []string) (*ssh.ClientConfig, error) {
 	}, nil
 }
 
+func (client *NativeClient) connect() error {
+	if client.conn != nil {
+		return nil
+	}
+	config, err := clientConfig(client.User, client.Keys)
+	if err != nil {
+		return fmt.Errorf("Error getting config for native Go SSH: %s", err)
+	}
+	client.conn, err = ssh.Dial("tcp", net.JoinHostPort(client.Hostname, strconv.Itoa(client.Port)), config)
+	return err
+}
+
 func (client *NativeClient) session() (*ssh.Session, error) {
-	if client.conn == nil {
-		var err error
-		config, err := clientConfig(client.User, client.Keys)
-		if err != nil {
-			return nil, fmt.Errorf("Error getting config for native Go SSH: %s", err)
-		}
-		client.conn, err = ssh.Dial("tcp", net.JoinHostPort(client.Hostname, strconv.Itoa(client.Port)), config)
-		if err != nil {
-			return nil, err
-		}
+	if err := client.connect(); err != nil {
+		return nil, err
 	}
 	session, err := client.conn.NewSession()
 	if err != nil {
@@ -109,6 +117,51 @@ func (client *NativeClient) Run(command string) ([]byte, []byte, error) {
 	return stdout.Bytes(), stderr.Bytes(), err
 }
 
+func (client *NativeClient) Stream(command string, stdin io.Reader, stdout, stderr io.Writer) error {
+	session, err := client.session()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	session.Stdin = stdin
+	session.Stdout = stdout
+	session.Stderr = stderr
+	return session.Run(command)
+}
+
+func (client *NativeClient) Shell(term string, width, height int, stdin io.Reader, stdout, stderr io.Writer) error {
+	session, err := client.session()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	modes := ssh.TerminalModes{
+		ssh.ECHO:          1,
+		ssh.TTY_OP_ISPEED: 14400,
+		ssh.TTY_OP_OSPEED: 14400,
+	}
+	if err := session.RequestPty(term, height, width, modes); err != nil {
+		return err
+	}
+	session.Stdin = stdin
+	session.Stdout = stdout
+	session.Stderr = stderr
+	if err := session.Shell(); err != nil {
+		return err
+	}
+	return session.Wait()
+}
+
+// Dial opens a connection to the address from the VM
+func (client *NativeClient) Dial(network, address string) (net.Conn, error) {
+	if err := client.connect(); err != nil {
+		return nil, err
+	}
+	return client.conn.Dial(network, address)
+}
+
 func (client *NativeClient) Close() {
 	if client.conn == nil {
 		return
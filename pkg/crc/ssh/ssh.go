@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"os"
 	"strings"
 	"time"
@@ -52,6 +54,24 @@ func (runner *Runner) RunPrivileged(reason string, cmdAndArgs ...string) (string
 	return runner.runSSHCommand(commandline, false)
 }
 
+// Stream runs the command with the given standard streams, the returned
+// error is a *ssh.ExitError when the command fails
+func (runner *Runner) Stream(command string, stdin io.Reader, stdout, stderr io.Writer) error {
+	logging.Debugf("Running SSH command: %s", command)
+	return runner.client.Stream(command, stdin, stdout, stderr)
+}
+
+// Shell runs an interactive login shell in a pseudo-terminal of the given size
+func (runner *Runner) Shell(term string, width, height int, stdin io.Reader, stdout, stderr io.Writer) error {
+	return runner.client.Shell(term, width, height, stdin, stdout, stderr)
+}
+
+// Dial opens a connection to the address from the VM, the connection is
+// tunnelled through SSH
+func (runner *Runner) Dial(network, address string) (net.Conn, error) {
+	return runner.client.Dial(network, address)
+}
+
 func (runner *Runner) CopyData(data []byte, destFilename string, mode os.FileMode) error {
 	logging.Debugf("Creating %s with permissions 0%o in the CRC VM", destFilename, mode)
 	base64Data := base64.StdEncoding.EncodeToString(data)
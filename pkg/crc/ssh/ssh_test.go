@@ -7,6 +7,7 @@ import (
 	"crypto/rand"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -61,6 +62,41 @@ func TestRunner(t *testing.T) {
 	assert.Equal(t, 1, *totalConn)
 }
 
+func TestRunnerStream(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ssh")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	require.NoError(t, err)
+
+	clientKeyFile := filepath.Join(dir, "private.key")
+	writePrivateKey(t, clientKeyFile, clientKey)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	_ = createSSHServer(t, listener, clientKey, func(input string) (byte, string) {
+		if input == "crictl ps" {
+			return 3, "no containers"
+		}
+		return 1, fmt.Sprintf("unexpected command: %q", input)
+	})
+
+	addr := listener.Addr().String()
+	runner, err := CreateRunner(ipFor(addr), portFor(addr), clientKeyFile)
+	assert.NoError(t, err)
+	defer runner.Close()
+
+	stdout := new(bytes.Buffer)
+	err = runner.Stream("crictl ps", nil, stdout, ioutil.Discard)
+	var exitErr *ssh.ExitError
+	require.True(t, errors.As(err, &exitErr))
+	assert.Equal(t, 3, exitErr.ExitStatus())
+	assert.Equal(t, "no containers", stdout.String())
+}
+
 func createSSHServer(t *testing.T, listener net.Listener, clientKey *ecdsa.PrivateKey, fun func(string) (byte, string)) *int {
 	totalConn := 0
 	config := &ssh.ServerConfig{
@@ -2,6 +2,7 @@ package systemd
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/code-ready/crc/pkg/crc/ssh"
 	"github.com/code-ready/crc/pkg/crc/systemd/actions"
@@ -57,6 +58,19 @@ func (c Commander) Status(name string) (states.State, error) {
 
 }
 
+// JournalCommand returns the journalctl command line printing the journal of
+// a unit, with follow it keeps printing the new entries
+func JournalCommand(name string, since time.Duration, follow bool) []string {
+	args := []string{"journalctl", "--unit", name, "--no-pager"}
+	if since > 0 {
+		args = append(args, fmt.Sprintf("--since=-%ds", int(since.Seconds())))
+	}
+	if follow {
+		args = append(args, "--follow")
+	}
+	return args
+}
+
 func (c Commander) DaemonReload() error {
 	stdOut, stdErr, err := c.commandRunner.RunPrivileged("Executing systemctl daemon-reload command", "systemctl", "daemon-reload")
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/code-ready/crc/pkg/crc/systemd/states"
 
@@ -180,3 +181,8 @@ func assertSystemCtlCommands(t *testing.T, systemctlCommands []string, cmd strin
 func assertSystemCtlCommand(t *testing.T, systemctlCommand string, cmd string, args []string) {
 	assertSystemCtlCommands(t, []string{systemctlCommand}, cmd, args)
 }
+
+func TestJournalCommand(t *testing.T) {
+	assert.Equal(t, []string{"journalctl", "--unit", "kubelet", "--no-pager"}, JournalCommand("kubelet", 0, false))
+	assert.Equal(t, []string{"journalctl", "--unit", "crio", "--no-pager", "--since=-600s", "--follow"}, JournalCommand("crio", 10*time.Minute, true))
+}
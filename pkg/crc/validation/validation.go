@@ -14,6 +14,7 @@ import (
 	"github.com/code-ready/crc/pkg/crc/machine/bundle"
 	"github.com/docker/go-units"
 	"github.com/pbnjay/memory"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 )
 
 // ValidateCPUs checks if provided cpus count is valid
@@ -100,6 +101,27 @@ func ValidateIPAddress(ipAddress string) error {
 	return nil
 }
 
+// ValidateDomain checks if the value is a DNS domain with at least two labels,
+// like 'crc.example.com'
+func ValidateDomain(domain string) error {
+	if errs := k8svalidation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+		return fmt.Errorf("'%s' is not a valid domain: %s", domain, strings.Join(errs, ", "))
+	}
+	if !strings.Contains(domain, ".") {
+		return fmt.Errorf("'%s' is not a valid domain: it must have at least two labels, like 'crc.example.com'", domain)
+	}
+	return nil
+}
+
+// ValidateHostname checks if the value is a DNS name, like 'registry' or
+// 'registry.example.com'
+func ValidateHostname(name string) error {
+	if errs := k8svalidation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("'%s' is not a valid hostname: %s", name, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
 // ValidatePath check if provide path is exist
 func ValidatePath(path string) error {
 	if _, err := os.Stat(path); os.IsNotExist(err) {
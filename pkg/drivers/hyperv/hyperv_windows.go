@@ -101,6 +101,8 @@ func (d *Driver) GetState() (state.State, error) {
 		return state.Running, nil
 	case "Off":
 		return state.Stopped, nil
+	case "Paused":
+		return state.Paused, nil
 	default:
 		return state.Error, fmt.Errorf("unexpected Hyper-V state %s", resp[0])
 	}
@@ -95,6 +95,30 @@ func (s Filestore) Exists(name string) (bool, error) {
 	return false, err
 }
 
+func (s Filestore) List() ([]string, error) {
+	entries, err := ioutil.ReadDir(s.MachinesDir)
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		exists, err := s.Exists(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
 func (s Filestore) Load(name string) (*host.Host, error) {
 	hostPath := filepath.Join(s.MachinesDir, name)
 
@@ -103,6 +103,34 @@ func TestStoreExists(t *testing.T) {
 	assert.False(t, exists)
 }
 
+func TestStoreList(t *testing.T) {
+	store, cleanup, err := getTestStore()
+	assert.NoError(t, err)
+	defer cleanup()
+
+	names, err := store.List()
+	assert.NoError(t, err)
+	assert.Empty(t, names)
+
+	h := testHost()
+	assert.NoError(t, store.Save(h))
+
+	names, err = store.List()
+	assert.NoError(t, err)
+	assert.Empty(t, names)
+
+	assert.NoError(t, store.SetExists(h.Name))
+
+	other := testHost()
+	other.Name = "other-host"
+	assert.NoError(t, store.Save(other))
+	assert.NoError(t, store.SetExists(other.Name))
+
+	names, err = store.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"other-host", "test-host"}, names)
+}
+
 func TestStoreLoad(t *testing.T) {
 	store, cleanup, err := getTestStore()
 	assert.NoError(t, err)
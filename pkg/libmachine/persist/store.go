@@ -11,6 +11,9 @@ type Store interface {
 	// Exists returns whether a machine exists or not
 	Exists(name string) (bool, error)
 
+	// List returns the names of all the machines which exist
+	List() ([]string, error)
+
 	// Load loads a host by name
 	Load(name string) (*host.Host, error)
 